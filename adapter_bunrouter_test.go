@@ -0,0 +1,10 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build bunrouter || frameworks
+// +build bunrouter frameworks
+
+package main
+
+import _ "github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/bunrouteradapter"
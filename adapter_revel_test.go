@@ -0,0 +1,15 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build revel
+// +build revel
+
+package main
+
+import _ "github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/reveladapter"
+
+// Revel is not part of the flat benchmarks, see TestFlatBenchmarks.
+func init() {
+	optInFrameworks = true
+}
@@ -0,0 +1,142 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// agreementCorpus is the corpus of the routing agreement test. Half of its
+// requests match no route.
+var agreementCorpus = fixtures.CorpusConfig{
+	Requests:    500,
+	MissRatio:   0.5,
+	ParamLenMin: 1,
+	ParamLenMax: 16,
+	Seed:        1,
+}
+
+// TestRoutingAgreement requests a corpus of valid and invalid paths from every
+// router loaded with the routes of a scenario and compares the outcomes, i.e.
+// whether and how the routers matched the requests. With -v, it logs a matrix
+// of the number of requests for which the outcomes of two routers differ,
+// which documents the semantic differences of the routers, e.g. trailing
+// slash redirects. Requests matching a route must be routed to it by every
+// router.
+func TestRoutingAgreement(t *testing.T) {
+	for _, s := range selectedScenarios() {
+		var names []string
+		var loaded []adapters.Router
+		for _, router := range routers {
+			if router.Capabilities.Check(s.Routes()) == nil {
+				names = append(names, router.Name)
+				loaded = append(loaded, router)
+			}
+		}
+		if len(loaded) == 0 {
+			continue
+		}
+
+		requests := fixtures.GenerateRequests(s.Routes(), agreementCorpus)
+		// requests with a trailing slash are a common source of divergence
+		for i := 0; i < len(requests); i += 10 {
+			if route := requests[i]; !strings.HasSuffix(route.Path, "/") {
+				requests = append(requests, fixtures.Route{Method: route.Method, Path: route.Path + "/"})
+			}
+		}
+
+		outcomes := make([][]string, len(loaded)+1)
+		outcomes[0] = make([]string, len(requests))
+		for i, req := range requests {
+			outcomes[0][i] = referenceOutcome(s.Routes(), req)
+		}
+		for j, router := range loaded {
+			h := router.Load(s.Routes(), adapters.HandlerTest)
+			outcomes[j+1] = make([]string, len(requests))
+			for i, req := range requests {
+				got := routingOutcome(h, req)
+				outcomes[j+1][i] = got
+				if outcomes[0][i] == "match" && got != "match" {
+					t.Errorf("%s in scenario %s: %s %s: got %s; expected match", router.Name, s.Name(), req.Method, req.Path, got)
+				}
+			}
+		}
+
+		t.Logf("%s: divergences of %d requests\n%s", s.Name(), len(requests),
+			divergenceMatrix(append([]string{"Reference"}, names...), outcomes))
+	}
+}
+
+// routingOutcome requests the route from the router, which must be loaded with
+// the test handlers, and returns the outcome: "match", the status code or, for
+// redirects, the status code and the location.
+func routingOutcome(h http.Handler, route fixtures.Route) (outcome string) {
+	defer func() {
+		if err := recover(); err != nil {
+			outcome = "panic"
+		}
+	}()
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(route.Method, route.Path, nil))
+	switch {
+	case w.Code == 200 && w.Body.String() == route.Path:
+		return "match"
+	case w.Code >= 300 && w.Code < 400:
+		return fmt.Sprintf("%d %s", w.Code, w.Header().Get("Location"))
+	}
+	return fmt.Sprint(w.Code)
+}
+
+// referenceOutcome returns the outcome of a request according to
+// fixtures.Match: "match" if a route of the method matches, 405 if only
+// routes of other methods match and 404 otherwise.
+func referenceOutcome(routes []fixtures.Route, req fixtures.Route) string {
+	outcome := "404"
+	for _, route := range routes {
+		if fixtures.Match(route.Path, req.Path) {
+			if route.Method == req.Method {
+				return "match"
+			}
+			outcome = "405"
+		}
+	}
+	return outcome
+}
+
+// divergenceMatrix formats the number of requests for which the outcomes of
+// each pair of routers differ.
+func divergenceMatrix(names []string, outcomes [][]string) string {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 1, ' ', tabwriter.AlignRight)
+	fmt.Fprint(tw, "\t")
+	for _, name := range names {
+		fmt.Fprintf(tw, "%s\t", name)
+	}
+	fmt.Fprintln(tw)
+	for i, name := range names {
+		fmt.Fprintf(tw, "%s\t", name)
+		for j := range names {
+			n := 0
+			for k := range outcomes[i] {
+				if outcomes[i][k] != outcomes[j][k] {
+					n++
+				}
+			}
+			fmt.Fprintf(tw, "%d\t", n)
+		}
+		fmt.Fprintln(tw)
+	}
+	tw.Flush()
+	return b.String()
+}
@@ -0,0 +1,59 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+// allocBudgets are the maximum allocations per request of routers in micro
+// benchmarks, which catch allocations introduced e.g. by dependency bumps.
+var allocBudgets = []struct {
+	router string
+	micro  string
+	allocs float64
+}{
+	{"Echo", "Param", 0},
+	{"Echo", "Param5", 0},
+	{"Echo", "Param20", 0},
+	{"Gin", "Param", 0},
+	{"Gin", "Param5", 0},
+	{"Gin", "Param20", 0},
+	// httprouter v1.3 allocates the Params of every request with params
+	{"HttpRouter", "Param", 1},
+	{"HttpRouter", "Param5", 1},
+	{"HttpRouter", "Param20", 1},
+}
+
+func TestAllocBudgets(t *testing.T) {
+	for _, budget := range allocBudgets {
+		registered := false
+		for _, router := range routers {
+			if router.Name != budget.router {
+				continue
+			}
+			registered = true
+			for _, bm := range microBenchmarks {
+				if bm.name != budget.micro {
+					continue
+				}
+				h := router.LoadSingle("GET", bm.path, bm.kind)
+				req, _ := http.NewRequest("GET", bm.request, nil)
+				w := new(mockResponseWriter)
+
+				allocs := testing.AllocsPerRun(100, func() {
+					h.ServeHTTP(w, req)
+				})
+				if allocs > budget.allocs {
+					t.Errorf("%s in micro benchmark %s: %v allocs per request; budget is %v", router.Name, bm.name, allocs, budget.allocs)
+				}
+			}
+		}
+		if !registered {
+			t.Logf("%s is not registered, not checking its budget in micro benchmark %s", budget.router, budget.micro)
+		}
+	}
+}
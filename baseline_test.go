@@ -0,0 +1,37 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/scenarios"
+)
+
+// baselineName is the name of the baseline in the API benchmarks, which is
+// selected by the -routers flag like a router.
+const baselineName = "Baseline"
+
+// baseline serves every request with an empty handler, without routing it. Its
+// results are the overhead of the benchmarks, e.g. for creating the requests,
+// which is part of the results of every router.
+var baseline http.Handler = http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})
+
+// benchBaseline runs the requests of a scenario against the baseline, named
+// BenchmarkAPI/<Scenario>/Baseline/<Request>.
+func benchBaseline(b *testing.B, s scenarios.Scenario, requests []scenarios.Request) {
+	if !routerSelected(baselineName) || s.HandlerKind() != adapters.HandlerEmpty {
+		return
+	}
+	b.Run(baselineName, func(b *testing.B) {
+		for _, req := range requests {
+			b.Run(req.Name, func(b *testing.B) {
+				benchScenarioRequest(b, baseline, s.HandlerKind(), req)
+			})
+		}
+	})
+}
@@ -0,0 +1,2010 @@
+// Code generated by "go test -tags frameworks -run=^TestFlatBenchmarks$ -generate"; DO NOT EDIT.
+
+//go:build flatbench
+// +build flatbench
+
+package main
+
+import "testing"
+
+// Micro Param
+
+func BenchmarkBeego_Param(b *testing.B) {
+	benchMicroRouter(b, "Beego", "Param")
+}
+
+func BenchmarkBuffalo_Param(b *testing.B) {
+	benchMicroRouter(b, "Buffalo", "Param")
+}
+
+func BenchmarkBunRouter_Param(b *testing.B) {
+	benchMicroRouter(b, "BunRouter", "Param")
+}
+
+func BenchmarkChi_Param(b *testing.B) {
+	benchMicroRouter(b, "Chi", "Param")
+}
+
+func BenchmarkChiV4_Param(b *testing.B) {
+	benchMicroRouter(b, "ChiV4", "Param")
+}
+
+func BenchmarkEcho_Param(b *testing.B) {
+	benchMicroRouter(b, "Echo", "Param")
+}
+
+func BenchmarkEchoV3_Param(b *testing.B) {
+	benchMicroRouter(b, "EchoV3", "Param")
+}
+
+func BenchmarkFastHttpRouter_Param(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouter", "Param")
+}
+
+func BenchmarkFastHttpRouting_Param(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouting", "Param")
+}
+
+func BenchmarkFiber_Param(b *testing.B) {
+	benchMicroRouter(b, "Fiber", "Param")
+}
+
+func BenchmarkGin_Param(b *testing.B) {
+	benchMicroRouter(b, "Gin", "Param")
+}
+
+func BenchmarkGoFrame_Param(b *testing.B) {
+	benchMicroRouter(b, "GoFrame", "Param")
+}
+
+func BenchmarkGoJsonRest_Param(b *testing.B) {
+	benchMicroRouter(b, "GoJsonRest", "Param")
+}
+
+func BenchmarkGoRestful_Param(b *testing.B) {
+	benchMicroRouter(b, "GoRestful", "Param")
+}
+
+func BenchmarkGoZero_Param(b *testing.B) {
+	benchMicroRouter(b, "GoZero", "Param")
+}
+
+func BenchmarkGocraftWeb_Param(b *testing.B) {
+	benchMicroRouter(b, "GocraftWeb", "Param")
+}
+
+func BenchmarkGoji_Param(b *testing.B) {
+	benchMicroRouter(b, "Goji", "Param")
+}
+
+func BenchmarkGojiIO_Param(b *testing.B) {
+	benchMicroRouter(b, "GojiIO", "Param")
+}
+
+func BenchmarkGorillaMux_Param(b *testing.B) {
+	benchMicroRouter(b, "GorillaMux", "Param")
+}
+
+func BenchmarkGorillaPat_Param(b *testing.B) {
+	benchMicroRouter(b, "GorillaPat", "Param")
+}
+
+func BenchmarkHertz_Param(b *testing.B) {
+	benchMicroRouter(b, "Hertz", "Param")
+}
+
+func BenchmarkHttpRouter_Param(b *testing.B) {
+	benchMicroRouter(b, "HttpRouter", "Param")
+}
+
+func BenchmarkHttpTreeMux_Param(b *testing.B) {
+	benchMicroRouter(b, "HttpTreeMux", "Param")
+}
+
+func BenchmarkIris_Param(b *testing.B) {
+	benchMicroRouter(b, "Iris", "Param")
+}
+
+func BenchmarkKratos_Param(b *testing.B) {
+	benchMicroRouter(b, "Kratos", "Param")
+}
+
+func BenchmarkLegacyServeMux_Param(b *testing.B) {
+	benchMicroRouter(b, "LegacyServeMux", "Param")
+}
+
+func BenchmarkMacaron_Param(b *testing.B) {
+	benchMicroRouter(b, "Macaron", "Param")
+}
+
+func BenchmarkMartini_Param(b *testing.B) {
+	benchMicroRouter(b, "Martini", "Param")
+}
+
+func BenchmarkMuxie_Param(b *testing.B) {
+	benchMicroRouter(b, "Muxie", "Param")
+}
+
+func BenchmarkNegroniHttpRouter_Param(b *testing.B) {
+	benchMicroRouter(b, "NegroniHttpRouter", "Param")
+}
+
+func BenchmarkOzzoRouting_Param(b *testing.B) {
+	benchMicroRouter(b, "OzzoRouting", "Param")
+}
+
+func BenchmarkPat_Param(b *testing.B) {
+	benchMicroRouter(b, "Pat", "Param")
+}
+
+func BenchmarkPure_Param(b *testing.B) {
+	benchMicroRouter(b, "Pure", "Param")
+}
+
+func BenchmarkServeMux_Param(b *testing.B) {
+	benchMicroRouter(b, "ServeMux", "Param")
+}
+
+func BenchmarkVestigo_Param(b *testing.B) {
+	benchMicroRouter(b, "Vestigo", "Param")
+}
+
+func BenchmarkVulcandRoute_Param(b *testing.B) {
+	benchMicroRouter(b, "VulcandRoute", "Param")
+}
+
+func BenchmarkWay_Param(b *testing.B) {
+	benchMicroRouter(b, "Way", "Param")
+}
+
+func BenchmarkXujiajunGorouter_Param(b *testing.B) {
+	benchMicroRouter(b, "XujiajunGorouter", "Param")
+}
+
+// Micro Param5
+
+func BenchmarkBeego_Param5(b *testing.B) {
+	benchMicroRouter(b, "Beego", "Param5")
+}
+
+func BenchmarkBuffalo_Param5(b *testing.B) {
+	benchMicroRouter(b, "Buffalo", "Param5")
+}
+
+func BenchmarkBunRouter_Param5(b *testing.B) {
+	benchMicroRouter(b, "BunRouter", "Param5")
+}
+
+func BenchmarkChi_Param5(b *testing.B) {
+	benchMicroRouter(b, "Chi", "Param5")
+}
+
+func BenchmarkChiV4_Param5(b *testing.B) {
+	benchMicroRouter(b, "ChiV4", "Param5")
+}
+
+func BenchmarkEcho_Param5(b *testing.B) {
+	benchMicroRouter(b, "Echo", "Param5")
+}
+
+func BenchmarkEchoV3_Param5(b *testing.B) {
+	benchMicroRouter(b, "EchoV3", "Param5")
+}
+
+func BenchmarkFastHttpRouter_Param5(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouter", "Param5")
+}
+
+func BenchmarkFastHttpRouting_Param5(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouting", "Param5")
+}
+
+func BenchmarkFiber_Param5(b *testing.B) {
+	benchMicroRouter(b, "Fiber", "Param5")
+}
+
+func BenchmarkGin_Param5(b *testing.B) {
+	benchMicroRouter(b, "Gin", "Param5")
+}
+
+func BenchmarkGoFrame_Param5(b *testing.B) {
+	benchMicroRouter(b, "GoFrame", "Param5")
+}
+
+func BenchmarkGoJsonRest_Param5(b *testing.B) {
+	benchMicroRouter(b, "GoJsonRest", "Param5")
+}
+
+func BenchmarkGoRestful_Param5(b *testing.B) {
+	benchMicroRouter(b, "GoRestful", "Param5")
+}
+
+func BenchmarkGoZero_Param5(b *testing.B) {
+	benchMicroRouter(b, "GoZero", "Param5")
+}
+
+func BenchmarkGocraftWeb_Param5(b *testing.B) {
+	benchMicroRouter(b, "GocraftWeb", "Param5")
+}
+
+func BenchmarkGoji_Param5(b *testing.B) {
+	benchMicroRouter(b, "Goji", "Param5")
+}
+
+func BenchmarkGojiIO_Param5(b *testing.B) {
+	benchMicroRouter(b, "GojiIO", "Param5")
+}
+
+func BenchmarkGorillaMux_Param5(b *testing.B) {
+	benchMicroRouter(b, "GorillaMux", "Param5")
+}
+
+func BenchmarkGorillaPat_Param5(b *testing.B) {
+	benchMicroRouter(b, "GorillaPat", "Param5")
+}
+
+func BenchmarkHertz_Param5(b *testing.B) {
+	benchMicroRouter(b, "Hertz", "Param5")
+}
+
+func BenchmarkHttpRouter_Param5(b *testing.B) {
+	benchMicroRouter(b, "HttpRouter", "Param5")
+}
+
+func BenchmarkHttpTreeMux_Param5(b *testing.B) {
+	benchMicroRouter(b, "HttpTreeMux", "Param5")
+}
+
+func BenchmarkIris_Param5(b *testing.B) {
+	benchMicroRouter(b, "Iris", "Param5")
+}
+
+func BenchmarkKratos_Param5(b *testing.B) {
+	benchMicroRouter(b, "Kratos", "Param5")
+}
+
+func BenchmarkLegacyServeMux_Param5(b *testing.B) {
+	benchMicroRouter(b, "LegacyServeMux", "Param5")
+}
+
+func BenchmarkMacaron_Param5(b *testing.B) {
+	benchMicroRouter(b, "Macaron", "Param5")
+}
+
+func BenchmarkMartini_Param5(b *testing.B) {
+	benchMicroRouter(b, "Martini", "Param5")
+}
+
+func BenchmarkMuxie_Param5(b *testing.B) {
+	benchMicroRouter(b, "Muxie", "Param5")
+}
+
+func BenchmarkNegroniHttpRouter_Param5(b *testing.B) {
+	benchMicroRouter(b, "NegroniHttpRouter", "Param5")
+}
+
+func BenchmarkOzzoRouting_Param5(b *testing.B) {
+	benchMicroRouter(b, "OzzoRouting", "Param5")
+}
+
+func BenchmarkPat_Param5(b *testing.B) {
+	benchMicroRouter(b, "Pat", "Param5")
+}
+
+func BenchmarkPure_Param5(b *testing.B) {
+	benchMicroRouter(b, "Pure", "Param5")
+}
+
+func BenchmarkServeMux_Param5(b *testing.B) {
+	benchMicroRouter(b, "ServeMux", "Param5")
+}
+
+func BenchmarkVestigo_Param5(b *testing.B) {
+	benchMicroRouter(b, "Vestigo", "Param5")
+}
+
+func BenchmarkVulcandRoute_Param5(b *testing.B) {
+	benchMicroRouter(b, "VulcandRoute", "Param5")
+}
+
+func BenchmarkWay_Param5(b *testing.B) {
+	benchMicroRouter(b, "Way", "Param5")
+}
+
+func BenchmarkXujiajunGorouter_Param5(b *testing.B) {
+	benchMicroRouter(b, "XujiajunGorouter", "Param5")
+}
+
+// Micro Param20
+
+func BenchmarkBeego_Param20(b *testing.B) {
+	benchMicroRouter(b, "Beego", "Param20")
+}
+
+func BenchmarkBuffalo_Param20(b *testing.B) {
+	benchMicroRouter(b, "Buffalo", "Param20")
+}
+
+func BenchmarkBunRouter_Param20(b *testing.B) {
+	benchMicroRouter(b, "BunRouter", "Param20")
+}
+
+func BenchmarkChi_Param20(b *testing.B) {
+	benchMicroRouter(b, "Chi", "Param20")
+}
+
+func BenchmarkChiV4_Param20(b *testing.B) {
+	benchMicroRouter(b, "ChiV4", "Param20")
+}
+
+func BenchmarkEcho_Param20(b *testing.B) {
+	benchMicroRouter(b, "Echo", "Param20")
+}
+
+func BenchmarkEchoV3_Param20(b *testing.B) {
+	benchMicroRouter(b, "EchoV3", "Param20")
+}
+
+func BenchmarkFastHttpRouter_Param20(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouter", "Param20")
+}
+
+func BenchmarkFastHttpRouting_Param20(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouting", "Param20")
+}
+
+func BenchmarkFiber_Param20(b *testing.B) {
+	benchMicroRouter(b, "Fiber", "Param20")
+}
+
+func BenchmarkGin_Param20(b *testing.B) {
+	benchMicroRouter(b, "Gin", "Param20")
+}
+
+func BenchmarkGoFrame_Param20(b *testing.B) {
+	benchMicroRouter(b, "GoFrame", "Param20")
+}
+
+func BenchmarkGoJsonRest_Param20(b *testing.B) {
+	benchMicroRouter(b, "GoJsonRest", "Param20")
+}
+
+func BenchmarkGoRestful_Param20(b *testing.B) {
+	benchMicroRouter(b, "GoRestful", "Param20")
+}
+
+func BenchmarkGoZero_Param20(b *testing.B) {
+	benchMicroRouter(b, "GoZero", "Param20")
+}
+
+func BenchmarkGocraftWeb_Param20(b *testing.B) {
+	benchMicroRouter(b, "GocraftWeb", "Param20")
+}
+
+func BenchmarkGoji_Param20(b *testing.B) {
+	benchMicroRouter(b, "Goji", "Param20")
+}
+
+func BenchmarkGojiIO_Param20(b *testing.B) {
+	benchMicroRouter(b, "GojiIO", "Param20")
+}
+
+func BenchmarkGorillaMux_Param20(b *testing.B) {
+	benchMicroRouter(b, "GorillaMux", "Param20")
+}
+
+func BenchmarkGorillaPat_Param20(b *testing.B) {
+	benchMicroRouter(b, "GorillaPat", "Param20")
+}
+
+func BenchmarkHertz_Param20(b *testing.B) {
+	benchMicroRouter(b, "Hertz", "Param20")
+}
+
+func BenchmarkHttpRouter_Param20(b *testing.B) {
+	benchMicroRouter(b, "HttpRouter", "Param20")
+}
+
+func BenchmarkHttpTreeMux_Param20(b *testing.B) {
+	benchMicroRouter(b, "HttpTreeMux", "Param20")
+}
+
+func BenchmarkIris_Param20(b *testing.B) {
+	benchMicroRouter(b, "Iris", "Param20")
+}
+
+func BenchmarkKratos_Param20(b *testing.B) {
+	benchMicroRouter(b, "Kratos", "Param20")
+}
+
+func BenchmarkLegacyServeMux_Param20(b *testing.B) {
+	benchMicroRouter(b, "LegacyServeMux", "Param20")
+}
+
+func BenchmarkMacaron_Param20(b *testing.B) {
+	benchMicroRouter(b, "Macaron", "Param20")
+}
+
+func BenchmarkMartini_Param20(b *testing.B) {
+	benchMicroRouter(b, "Martini", "Param20")
+}
+
+func BenchmarkMuxie_Param20(b *testing.B) {
+	benchMicroRouter(b, "Muxie", "Param20")
+}
+
+func BenchmarkNegroniHttpRouter_Param20(b *testing.B) {
+	benchMicroRouter(b, "NegroniHttpRouter", "Param20")
+}
+
+func BenchmarkOzzoRouting_Param20(b *testing.B) {
+	benchMicroRouter(b, "OzzoRouting", "Param20")
+}
+
+func BenchmarkPat_Param20(b *testing.B) {
+	benchMicroRouter(b, "Pat", "Param20")
+}
+
+func BenchmarkPure_Param20(b *testing.B) {
+	benchMicroRouter(b, "Pure", "Param20")
+}
+
+func BenchmarkServeMux_Param20(b *testing.B) {
+	benchMicroRouter(b, "ServeMux", "Param20")
+}
+
+func BenchmarkVestigo_Param20(b *testing.B) {
+	benchMicroRouter(b, "Vestigo", "Param20")
+}
+
+func BenchmarkVulcandRoute_Param20(b *testing.B) {
+	benchMicroRouter(b, "VulcandRoute", "Param20")
+}
+
+func BenchmarkWay_Param20(b *testing.B) {
+	benchMicroRouter(b, "Way", "Param20")
+}
+
+func BenchmarkXujiajunGorouter_Param20(b *testing.B) {
+	benchMicroRouter(b, "XujiajunGorouter", "Param20")
+}
+
+// Micro ParamWrite
+
+func BenchmarkBeego_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Beego", "ParamWrite")
+}
+
+func BenchmarkBuffalo_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Buffalo", "ParamWrite")
+}
+
+func BenchmarkBunRouter_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "BunRouter", "ParamWrite")
+}
+
+func BenchmarkChi_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Chi", "ParamWrite")
+}
+
+func BenchmarkChiV4_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "ChiV4", "ParamWrite")
+}
+
+func BenchmarkEcho_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Echo", "ParamWrite")
+}
+
+func BenchmarkEchoV3_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "EchoV3", "ParamWrite")
+}
+
+func BenchmarkFastHttpRouter_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouter", "ParamWrite")
+}
+
+func BenchmarkFastHttpRouting_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouting", "ParamWrite")
+}
+
+func BenchmarkFiber_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Fiber", "ParamWrite")
+}
+
+func BenchmarkGin_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Gin", "ParamWrite")
+}
+
+func BenchmarkGoFrame_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "GoFrame", "ParamWrite")
+}
+
+func BenchmarkGoJsonRest_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "GoJsonRest", "ParamWrite")
+}
+
+func BenchmarkGoRestful_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "GoRestful", "ParamWrite")
+}
+
+func BenchmarkGoZero_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "GoZero", "ParamWrite")
+}
+
+func BenchmarkGocraftWeb_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "GocraftWeb", "ParamWrite")
+}
+
+func BenchmarkGoji_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Goji", "ParamWrite")
+}
+
+func BenchmarkGojiIO_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "GojiIO", "ParamWrite")
+}
+
+func BenchmarkGorillaMux_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "GorillaMux", "ParamWrite")
+}
+
+func BenchmarkGorillaPat_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "GorillaPat", "ParamWrite")
+}
+
+func BenchmarkHertz_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Hertz", "ParamWrite")
+}
+
+func BenchmarkHttpRouter_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "HttpRouter", "ParamWrite")
+}
+
+func BenchmarkHttpTreeMux_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "HttpTreeMux", "ParamWrite")
+}
+
+func BenchmarkIris_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Iris", "ParamWrite")
+}
+
+func BenchmarkKratos_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Kratos", "ParamWrite")
+}
+
+func BenchmarkLegacyServeMux_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "LegacyServeMux", "ParamWrite")
+}
+
+func BenchmarkMacaron_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Macaron", "ParamWrite")
+}
+
+func BenchmarkMartini_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Martini", "ParamWrite")
+}
+
+func BenchmarkMuxie_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Muxie", "ParamWrite")
+}
+
+func BenchmarkNegroniHttpRouter_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "NegroniHttpRouter", "ParamWrite")
+}
+
+func BenchmarkOzzoRouting_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "OzzoRouting", "ParamWrite")
+}
+
+func BenchmarkPat_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Pat", "ParamWrite")
+}
+
+func BenchmarkPure_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Pure", "ParamWrite")
+}
+
+func BenchmarkServeMux_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "ServeMux", "ParamWrite")
+}
+
+func BenchmarkVestigo_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Vestigo", "ParamWrite")
+}
+
+func BenchmarkVulcandRoute_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "VulcandRoute", "ParamWrite")
+}
+
+func BenchmarkWay_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "Way", "ParamWrite")
+}
+
+func BenchmarkXujiajunGorouter_ParamWrite(b *testing.B) {
+	benchMicroRouter(b, "XujiajunGorouter", "ParamWrite")
+}
+
+// Micro ParamContextWrite
+
+func BenchmarkBeego_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Beego", "ParamContextWrite")
+}
+
+func BenchmarkBuffalo_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Buffalo", "ParamContextWrite")
+}
+
+func BenchmarkBunRouter_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "BunRouter", "ParamContextWrite")
+}
+
+func BenchmarkChi_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Chi", "ParamContextWrite")
+}
+
+func BenchmarkChiV4_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "ChiV4", "ParamContextWrite")
+}
+
+func BenchmarkEcho_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Echo", "ParamContextWrite")
+}
+
+func BenchmarkEchoV3_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "EchoV3", "ParamContextWrite")
+}
+
+func BenchmarkFastHttpRouter_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouter", "ParamContextWrite")
+}
+
+func BenchmarkFastHttpRouting_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "FastHttpRouting", "ParamContextWrite")
+}
+
+func BenchmarkFiber_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Fiber", "ParamContextWrite")
+}
+
+func BenchmarkGin_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Gin", "ParamContextWrite")
+}
+
+func BenchmarkGoFrame_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "GoFrame", "ParamContextWrite")
+}
+
+func BenchmarkGoJsonRest_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "GoJsonRest", "ParamContextWrite")
+}
+
+func BenchmarkGoRestful_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "GoRestful", "ParamContextWrite")
+}
+
+func BenchmarkGoZero_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "GoZero", "ParamContextWrite")
+}
+
+func BenchmarkGocraftWeb_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "GocraftWeb", "ParamContextWrite")
+}
+
+func BenchmarkGoji_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Goji", "ParamContextWrite")
+}
+
+func BenchmarkGojiIO_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "GojiIO", "ParamContextWrite")
+}
+
+func BenchmarkGorillaMux_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "GorillaMux", "ParamContextWrite")
+}
+
+func BenchmarkGorillaPat_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "GorillaPat", "ParamContextWrite")
+}
+
+func BenchmarkHertz_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Hertz", "ParamContextWrite")
+}
+
+func BenchmarkHttpRouter_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "HttpRouter", "ParamContextWrite")
+}
+
+func BenchmarkHttpTreeMux_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "HttpTreeMux", "ParamContextWrite")
+}
+
+func BenchmarkIris_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Iris", "ParamContextWrite")
+}
+
+func BenchmarkKratos_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Kratos", "ParamContextWrite")
+}
+
+func BenchmarkLegacyServeMux_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "LegacyServeMux", "ParamContextWrite")
+}
+
+func BenchmarkMacaron_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Macaron", "ParamContextWrite")
+}
+
+func BenchmarkMartini_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Martini", "ParamContextWrite")
+}
+
+func BenchmarkMuxie_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Muxie", "ParamContextWrite")
+}
+
+func BenchmarkNegroniHttpRouter_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "NegroniHttpRouter", "ParamContextWrite")
+}
+
+func BenchmarkOzzoRouting_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "OzzoRouting", "ParamContextWrite")
+}
+
+func BenchmarkPat_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Pat", "ParamContextWrite")
+}
+
+func BenchmarkPure_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Pure", "ParamContextWrite")
+}
+
+func BenchmarkServeMux_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "ServeMux", "ParamContextWrite")
+}
+
+func BenchmarkVestigo_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Vestigo", "ParamContextWrite")
+}
+
+func BenchmarkVulcandRoute_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "VulcandRoute", "ParamContextWrite")
+}
+
+func BenchmarkWay_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "Way", "ParamContextWrite")
+}
+
+func BenchmarkXujiajunGorouter_ParamContextWrite(b *testing.B) {
+	benchMicroRouter(b, "XujiajunGorouter", "ParamContextWrite")
+}
+
+// Github Static
+
+func BenchmarkBeego_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Beego", "Static")
+}
+
+func BenchmarkBuffalo_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Buffalo", "Static")
+}
+
+func BenchmarkBunRouter_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "BunRouter", "Static")
+}
+
+func BenchmarkChi_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Chi", "Static")
+}
+
+func BenchmarkChiV4_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "ChiV4", "Static")
+}
+
+func BenchmarkEcho_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Echo", "Static")
+}
+
+func BenchmarkEchoV3_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "EchoV3", "Static")
+}
+
+func BenchmarkFastHttpRouter_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "FastHttpRouter", "Static")
+}
+
+func BenchmarkFastHttpRouting_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "FastHttpRouting", "Static")
+}
+
+func BenchmarkFiber_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Fiber", "Static")
+}
+
+func BenchmarkGin_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Gin", "Static")
+}
+
+func BenchmarkGoFrame_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "GoFrame", "Static")
+}
+
+func BenchmarkGoJsonRest_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "GoJsonRest", "Static")
+}
+
+func BenchmarkGoRestful_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "GoRestful", "Static")
+}
+
+func BenchmarkGoZero_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "GoZero", "Static")
+}
+
+func BenchmarkGocraftWeb_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "GocraftWeb", "Static")
+}
+
+func BenchmarkGoji_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Goji", "Static")
+}
+
+func BenchmarkGojiIO_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "GojiIO", "Static")
+}
+
+func BenchmarkGorillaMux_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "GorillaMux", "Static")
+}
+
+func BenchmarkGorillaPat_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "GorillaPat", "Static")
+}
+
+func BenchmarkHertz_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Hertz", "Static")
+}
+
+func BenchmarkHttpRouter_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "HttpRouter", "Static")
+}
+
+func BenchmarkHttpTreeMux_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "HttpTreeMux", "Static")
+}
+
+func BenchmarkIris_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Iris", "Static")
+}
+
+func BenchmarkKratos_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Kratos", "Static")
+}
+
+func BenchmarkLegacyServeMux_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "LegacyServeMux", "Static")
+}
+
+func BenchmarkMacaron_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Macaron", "Static")
+}
+
+func BenchmarkMartini_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Martini", "Static")
+}
+
+func BenchmarkMuxie_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Muxie", "Static")
+}
+
+func BenchmarkNegroniHttpRouter_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "NegroniHttpRouter", "Static")
+}
+
+func BenchmarkOzzoRouting_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "OzzoRouting", "Static")
+}
+
+func BenchmarkPat_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Pat", "Static")
+}
+
+func BenchmarkPure_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Pure", "Static")
+}
+
+func BenchmarkServeMux_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "ServeMux", "Static")
+}
+
+func BenchmarkVestigo_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Vestigo", "Static")
+}
+
+func BenchmarkVulcandRoute_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "VulcandRoute", "Static")
+}
+
+func BenchmarkWay_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "Way", "Static")
+}
+
+func BenchmarkXujiajunGorouter_GithubStatic(b *testing.B) {
+	benchScenario(b, "Github", "XujiajunGorouter", "Static")
+}
+
+// Github Param
+
+func BenchmarkBeego_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Beego", "Param")
+}
+
+func BenchmarkBuffalo_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Buffalo", "Param")
+}
+
+func BenchmarkBunRouter_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "BunRouter", "Param")
+}
+
+func BenchmarkChi_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Chi", "Param")
+}
+
+func BenchmarkChiV4_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "ChiV4", "Param")
+}
+
+func BenchmarkEcho_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Echo", "Param")
+}
+
+func BenchmarkEchoV3_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "EchoV3", "Param")
+}
+
+func BenchmarkFastHttpRouter_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "FastHttpRouter", "Param")
+}
+
+func BenchmarkFastHttpRouting_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "FastHttpRouting", "Param")
+}
+
+func BenchmarkFiber_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Fiber", "Param")
+}
+
+func BenchmarkGin_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Gin", "Param")
+}
+
+func BenchmarkGoFrame_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "GoFrame", "Param")
+}
+
+func BenchmarkGoJsonRest_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "GoJsonRest", "Param")
+}
+
+func BenchmarkGoRestful_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "GoRestful", "Param")
+}
+
+func BenchmarkGoZero_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "GoZero", "Param")
+}
+
+func BenchmarkGocraftWeb_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "GocraftWeb", "Param")
+}
+
+func BenchmarkGoji_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Goji", "Param")
+}
+
+func BenchmarkGojiIO_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "GojiIO", "Param")
+}
+
+func BenchmarkGorillaMux_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "GorillaMux", "Param")
+}
+
+func BenchmarkGorillaPat_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "GorillaPat", "Param")
+}
+
+func BenchmarkHertz_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Hertz", "Param")
+}
+
+func BenchmarkHttpRouter_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "HttpRouter", "Param")
+}
+
+func BenchmarkHttpTreeMux_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "HttpTreeMux", "Param")
+}
+
+func BenchmarkIris_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Iris", "Param")
+}
+
+func BenchmarkKratos_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Kratos", "Param")
+}
+
+func BenchmarkLegacyServeMux_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "LegacyServeMux", "Param")
+}
+
+func BenchmarkMacaron_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Macaron", "Param")
+}
+
+func BenchmarkMartini_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Martini", "Param")
+}
+
+func BenchmarkMuxie_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Muxie", "Param")
+}
+
+func BenchmarkNegroniHttpRouter_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "NegroniHttpRouter", "Param")
+}
+
+func BenchmarkOzzoRouting_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "OzzoRouting", "Param")
+}
+
+func BenchmarkPat_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Pat", "Param")
+}
+
+func BenchmarkPure_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Pure", "Param")
+}
+
+func BenchmarkServeMux_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "ServeMux", "Param")
+}
+
+func BenchmarkVestigo_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Vestigo", "Param")
+}
+
+func BenchmarkVulcandRoute_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "VulcandRoute", "Param")
+}
+
+func BenchmarkWay_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "Way", "Param")
+}
+
+func BenchmarkXujiajunGorouter_GithubParam(b *testing.B) {
+	benchScenario(b, "Github", "XujiajunGorouter", "Param")
+}
+
+// Github All
+
+func BenchmarkBeego_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Beego", "All")
+}
+
+func BenchmarkBuffalo_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Buffalo", "All")
+}
+
+func BenchmarkBunRouter_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "BunRouter", "All")
+}
+
+func BenchmarkChi_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Chi", "All")
+}
+
+func BenchmarkChiV4_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "ChiV4", "All")
+}
+
+func BenchmarkEcho_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Echo", "All")
+}
+
+func BenchmarkEchoV3_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "EchoV3", "All")
+}
+
+func BenchmarkFastHttpRouter_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "FastHttpRouter", "All")
+}
+
+func BenchmarkFastHttpRouting_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "FastHttpRouting", "All")
+}
+
+func BenchmarkFiber_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Fiber", "All")
+}
+
+func BenchmarkGin_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Gin", "All")
+}
+
+func BenchmarkGoFrame_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "GoFrame", "All")
+}
+
+func BenchmarkGoJsonRest_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "GoJsonRest", "All")
+}
+
+func BenchmarkGoRestful_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "GoRestful", "All")
+}
+
+func BenchmarkGoZero_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "GoZero", "All")
+}
+
+func BenchmarkGocraftWeb_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "GocraftWeb", "All")
+}
+
+func BenchmarkGoji_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Goji", "All")
+}
+
+func BenchmarkGojiIO_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "GojiIO", "All")
+}
+
+func BenchmarkGorillaMux_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "GorillaMux", "All")
+}
+
+func BenchmarkGorillaPat_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "GorillaPat", "All")
+}
+
+func BenchmarkHertz_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Hertz", "All")
+}
+
+func BenchmarkHttpRouter_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "HttpRouter", "All")
+}
+
+func BenchmarkHttpTreeMux_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "HttpTreeMux", "All")
+}
+
+func BenchmarkIris_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Iris", "All")
+}
+
+func BenchmarkKratos_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Kratos", "All")
+}
+
+func BenchmarkLegacyServeMux_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "LegacyServeMux", "All")
+}
+
+func BenchmarkMacaron_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Macaron", "All")
+}
+
+func BenchmarkMartini_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Martini", "All")
+}
+
+func BenchmarkMuxie_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Muxie", "All")
+}
+
+func BenchmarkNegroniHttpRouter_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "NegroniHttpRouter", "All")
+}
+
+func BenchmarkOzzoRouting_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "OzzoRouting", "All")
+}
+
+func BenchmarkPat_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Pat", "All")
+}
+
+func BenchmarkPure_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Pure", "All")
+}
+
+func BenchmarkServeMux_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "ServeMux", "All")
+}
+
+func BenchmarkVestigo_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Vestigo", "All")
+}
+
+func BenchmarkVulcandRoute_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "VulcandRoute", "All")
+}
+
+func BenchmarkWay_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "Way", "All")
+}
+
+func BenchmarkXujiajunGorouter_GithubAll(b *testing.B) {
+	benchScenario(b, "Github", "XujiajunGorouter", "All")
+}
+
+// Parse Static
+
+func BenchmarkBeego_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Beego", "Static")
+}
+
+func BenchmarkBuffalo_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Buffalo", "Static")
+}
+
+func BenchmarkBunRouter_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "BunRouter", "Static")
+}
+
+func BenchmarkChi_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Chi", "Static")
+}
+
+func BenchmarkChiV4_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "ChiV4", "Static")
+}
+
+func BenchmarkEcho_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Echo", "Static")
+}
+
+func BenchmarkEchoV3_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "EchoV3", "Static")
+}
+
+func BenchmarkFastHttpRouter_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "FastHttpRouter", "Static")
+}
+
+func BenchmarkFastHttpRouting_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "FastHttpRouting", "Static")
+}
+
+func BenchmarkFiber_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Fiber", "Static")
+}
+
+func BenchmarkGin_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Gin", "Static")
+}
+
+func BenchmarkGoFrame_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "GoFrame", "Static")
+}
+
+func BenchmarkGoJsonRest_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "GoJsonRest", "Static")
+}
+
+func BenchmarkGoRestful_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "GoRestful", "Static")
+}
+
+func BenchmarkGoZero_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "GoZero", "Static")
+}
+
+func BenchmarkGocraftWeb_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "GocraftWeb", "Static")
+}
+
+func BenchmarkGoji_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Goji", "Static")
+}
+
+func BenchmarkGojiIO_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "GojiIO", "Static")
+}
+
+func BenchmarkGorillaMux_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "GorillaMux", "Static")
+}
+
+func BenchmarkGorillaPat_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "GorillaPat", "Static")
+}
+
+func BenchmarkHertz_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Hertz", "Static")
+}
+
+func BenchmarkHttpRouter_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "HttpRouter", "Static")
+}
+
+func BenchmarkHttpTreeMux_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "HttpTreeMux", "Static")
+}
+
+func BenchmarkIris_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Iris", "Static")
+}
+
+func BenchmarkKratos_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Kratos", "Static")
+}
+
+func BenchmarkLegacyServeMux_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "LegacyServeMux", "Static")
+}
+
+func BenchmarkMacaron_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Macaron", "Static")
+}
+
+func BenchmarkMartini_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Martini", "Static")
+}
+
+func BenchmarkMuxie_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Muxie", "Static")
+}
+
+func BenchmarkNegroniHttpRouter_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "NegroniHttpRouter", "Static")
+}
+
+func BenchmarkOzzoRouting_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "OzzoRouting", "Static")
+}
+
+func BenchmarkPat_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Pat", "Static")
+}
+
+func BenchmarkPure_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Pure", "Static")
+}
+
+func BenchmarkServeMux_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "ServeMux", "Static")
+}
+
+func BenchmarkVestigo_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Vestigo", "Static")
+}
+
+func BenchmarkVulcandRoute_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "VulcandRoute", "Static")
+}
+
+func BenchmarkWay_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "Way", "Static")
+}
+
+func BenchmarkXujiajunGorouter_ParseStatic(b *testing.B) {
+	benchScenario(b, "Parse", "XujiajunGorouter", "Static")
+}
+
+// Parse Param
+
+func BenchmarkBeego_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Beego", "Param")
+}
+
+func BenchmarkBuffalo_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Buffalo", "Param")
+}
+
+func BenchmarkBunRouter_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "BunRouter", "Param")
+}
+
+func BenchmarkChi_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Chi", "Param")
+}
+
+func BenchmarkChiV4_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "ChiV4", "Param")
+}
+
+func BenchmarkEcho_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Echo", "Param")
+}
+
+func BenchmarkEchoV3_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "EchoV3", "Param")
+}
+
+func BenchmarkFastHttpRouter_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "FastHttpRouter", "Param")
+}
+
+func BenchmarkFastHttpRouting_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "FastHttpRouting", "Param")
+}
+
+func BenchmarkFiber_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Fiber", "Param")
+}
+
+func BenchmarkGin_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Gin", "Param")
+}
+
+func BenchmarkGoFrame_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "GoFrame", "Param")
+}
+
+func BenchmarkGoJsonRest_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "GoJsonRest", "Param")
+}
+
+func BenchmarkGoRestful_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "GoRestful", "Param")
+}
+
+func BenchmarkGoZero_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "GoZero", "Param")
+}
+
+func BenchmarkGocraftWeb_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "GocraftWeb", "Param")
+}
+
+func BenchmarkGoji_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Goji", "Param")
+}
+
+func BenchmarkGojiIO_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "GojiIO", "Param")
+}
+
+func BenchmarkGorillaMux_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "GorillaMux", "Param")
+}
+
+func BenchmarkGorillaPat_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "GorillaPat", "Param")
+}
+
+func BenchmarkHertz_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Hertz", "Param")
+}
+
+func BenchmarkHttpRouter_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "HttpRouter", "Param")
+}
+
+func BenchmarkHttpTreeMux_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "HttpTreeMux", "Param")
+}
+
+func BenchmarkIris_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Iris", "Param")
+}
+
+func BenchmarkKratos_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Kratos", "Param")
+}
+
+func BenchmarkLegacyServeMux_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "LegacyServeMux", "Param")
+}
+
+func BenchmarkMacaron_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Macaron", "Param")
+}
+
+func BenchmarkMartini_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Martini", "Param")
+}
+
+func BenchmarkMuxie_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Muxie", "Param")
+}
+
+func BenchmarkNegroniHttpRouter_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "NegroniHttpRouter", "Param")
+}
+
+func BenchmarkOzzoRouting_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "OzzoRouting", "Param")
+}
+
+func BenchmarkPat_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Pat", "Param")
+}
+
+func BenchmarkPure_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Pure", "Param")
+}
+
+func BenchmarkServeMux_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "ServeMux", "Param")
+}
+
+func BenchmarkVestigo_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Vestigo", "Param")
+}
+
+func BenchmarkVulcandRoute_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "VulcandRoute", "Param")
+}
+
+func BenchmarkWay_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "Way", "Param")
+}
+
+func BenchmarkXujiajunGorouter_ParseParam(b *testing.B) {
+	benchScenario(b, "Parse", "XujiajunGorouter", "Param")
+}
+
+// Parse Param2
+
+func BenchmarkBeego_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Beego", "Param2")
+}
+
+func BenchmarkBuffalo_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Buffalo", "Param2")
+}
+
+func BenchmarkBunRouter_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "BunRouter", "Param2")
+}
+
+func BenchmarkChi_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Chi", "Param2")
+}
+
+func BenchmarkChiV4_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "ChiV4", "Param2")
+}
+
+func BenchmarkEcho_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Echo", "Param2")
+}
+
+func BenchmarkEchoV3_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "EchoV3", "Param2")
+}
+
+func BenchmarkFastHttpRouter_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "FastHttpRouter", "Param2")
+}
+
+func BenchmarkFastHttpRouting_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "FastHttpRouting", "Param2")
+}
+
+func BenchmarkFiber_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Fiber", "Param2")
+}
+
+func BenchmarkGin_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Gin", "Param2")
+}
+
+func BenchmarkGoFrame_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "GoFrame", "Param2")
+}
+
+func BenchmarkGoJsonRest_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "GoJsonRest", "Param2")
+}
+
+func BenchmarkGoRestful_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "GoRestful", "Param2")
+}
+
+func BenchmarkGoZero_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "GoZero", "Param2")
+}
+
+func BenchmarkGocraftWeb_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "GocraftWeb", "Param2")
+}
+
+func BenchmarkGoji_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Goji", "Param2")
+}
+
+func BenchmarkGojiIO_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "GojiIO", "Param2")
+}
+
+func BenchmarkGorillaMux_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "GorillaMux", "Param2")
+}
+
+func BenchmarkGorillaPat_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "GorillaPat", "Param2")
+}
+
+func BenchmarkHertz_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Hertz", "Param2")
+}
+
+func BenchmarkHttpRouter_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "HttpRouter", "Param2")
+}
+
+func BenchmarkHttpTreeMux_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "HttpTreeMux", "Param2")
+}
+
+func BenchmarkIris_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Iris", "Param2")
+}
+
+func BenchmarkKratos_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Kratos", "Param2")
+}
+
+func BenchmarkLegacyServeMux_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "LegacyServeMux", "Param2")
+}
+
+func BenchmarkMacaron_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Macaron", "Param2")
+}
+
+func BenchmarkMartini_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Martini", "Param2")
+}
+
+func BenchmarkMuxie_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Muxie", "Param2")
+}
+
+func BenchmarkNegroniHttpRouter_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "NegroniHttpRouter", "Param2")
+}
+
+func BenchmarkOzzoRouting_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "OzzoRouting", "Param2")
+}
+
+func BenchmarkPat_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Pat", "Param2")
+}
+
+func BenchmarkPure_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Pure", "Param2")
+}
+
+func BenchmarkServeMux_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "ServeMux", "Param2")
+}
+
+func BenchmarkVestigo_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Vestigo", "Param2")
+}
+
+func BenchmarkVulcandRoute_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "VulcandRoute", "Param2")
+}
+
+func BenchmarkWay_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "Way", "Param2")
+}
+
+func BenchmarkXujiajunGorouter_ParseParam2(b *testing.B) {
+	benchScenario(b, "Parse", "XujiajunGorouter", "Param2")
+}
+
+// Parse All
+
+func BenchmarkBeego_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Beego", "All")
+}
+
+func BenchmarkBuffalo_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Buffalo", "All")
+}
+
+func BenchmarkBunRouter_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "BunRouter", "All")
+}
+
+func BenchmarkChi_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Chi", "All")
+}
+
+func BenchmarkChiV4_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "ChiV4", "All")
+}
+
+func BenchmarkEcho_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Echo", "All")
+}
+
+func BenchmarkEchoV3_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "EchoV3", "All")
+}
+
+func BenchmarkFastHttpRouter_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "FastHttpRouter", "All")
+}
+
+func BenchmarkFastHttpRouting_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "FastHttpRouting", "All")
+}
+
+func BenchmarkFiber_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Fiber", "All")
+}
+
+func BenchmarkGin_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Gin", "All")
+}
+
+func BenchmarkGoFrame_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "GoFrame", "All")
+}
+
+func BenchmarkGoJsonRest_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "GoJsonRest", "All")
+}
+
+func BenchmarkGoRestful_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "GoRestful", "All")
+}
+
+func BenchmarkGoZero_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "GoZero", "All")
+}
+
+func BenchmarkGocraftWeb_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "GocraftWeb", "All")
+}
+
+func BenchmarkGoji_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Goji", "All")
+}
+
+func BenchmarkGojiIO_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "GojiIO", "All")
+}
+
+func BenchmarkGorillaMux_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "GorillaMux", "All")
+}
+
+func BenchmarkGorillaPat_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "GorillaPat", "All")
+}
+
+func BenchmarkHertz_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Hertz", "All")
+}
+
+func BenchmarkHttpRouter_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "HttpRouter", "All")
+}
+
+func BenchmarkHttpTreeMux_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "HttpTreeMux", "All")
+}
+
+func BenchmarkIris_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Iris", "All")
+}
+
+func BenchmarkKratos_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Kratos", "All")
+}
+
+func BenchmarkLegacyServeMux_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "LegacyServeMux", "All")
+}
+
+func BenchmarkMacaron_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Macaron", "All")
+}
+
+func BenchmarkMartini_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Martini", "All")
+}
+
+func BenchmarkMuxie_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Muxie", "All")
+}
+
+func BenchmarkNegroniHttpRouter_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "NegroniHttpRouter", "All")
+}
+
+func BenchmarkOzzoRouting_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "OzzoRouting", "All")
+}
+
+func BenchmarkPat_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Pat", "All")
+}
+
+func BenchmarkPure_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Pure", "All")
+}
+
+func BenchmarkServeMux_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "ServeMux", "All")
+}
+
+func BenchmarkVestigo_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Vestigo", "All")
+}
+
+func BenchmarkVulcandRoute_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "VulcandRoute", "All")
+}
+
+func BenchmarkWay_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "Way", "All")
+}
+
+func BenchmarkXujiajunGorouter_ParseAll(b *testing.B) {
+	benchScenario(b, "Parse", "XujiajunGorouter", "All")
+}
+
+// Static All
+
+func BenchmarkBeego_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Beego", "All")
+}
+
+func BenchmarkBuffalo_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Buffalo", "All")
+}
+
+func BenchmarkBunRouter_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "BunRouter", "All")
+}
+
+func BenchmarkChi_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Chi", "All")
+}
+
+func BenchmarkChiV4_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "ChiV4", "All")
+}
+
+func BenchmarkEcho_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Echo", "All")
+}
+
+func BenchmarkEchoV3_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "EchoV3", "All")
+}
+
+func BenchmarkFastHttpRouter_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "FastHttpRouter", "All")
+}
+
+func BenchmarkFastHttpRouting_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "FastHttpRouting", "All")
+}
+
+func BenchmarkFiber_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Fiber", "All")
+}
+
+func BenchmarkGin_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Gin", "All")
+}
+
+func BenchmarkGoFrame_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "GoFrame", "All")
+}
+
+func BenchmarkGoJsonRest_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "GoJsonRest", "All")
+}
+
+func BenchmarkGoRestful_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "GoRestful", "All")
+}
+
+func BenchmarkGoZero_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "GoZero", "All")
+}
+
+func BenchmarkGocraftWeb_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "GocraftWeb", "All")
+}
+
+func BenchmarkGoji_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Goji", "All")
+}
+
+func BenchmarkGojiIO_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "GojiIO", "All")
+}
+
+func BenchmarkGorillaMux_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "GorillaMux", "All")
+}
+
+func BenchmarkGorillaPat_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "GorillaPat", "All")
+}
+
+func BenchmarkHertz_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Hertz", "All")
+}
+
+func BenchmarkHttpRouter_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "HttpRouter", "All")
+}
+
+func BenchmarkHttpTreeMux_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "HttpTreeMux", "All")
+}
+
+func BenchmarkIris_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Iris", "All")
+}
+
+func BenchmarkKratos_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Kratos", "All")
+}
+
+func BenchmarkLegacyServeMux_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "LegacyServeMux", "All")
+}
+
+func BenchmarkMacaron_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Macaron", "All")
+}
+
+func BenchmarkMartini_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Martini", "All")
+}
+
+func BenchmarkMuxie_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Muxie", "All")
+}
+
+func BenchmarkNegroniHttpRouter_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "NegroniHttpRouter", "All")
+}
+
+func BenchmarkOzzoRouting_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "OzzoRouting", "All")
+}
+
+func BenchmarkPat_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Pat", "All")
+}
+
+func BenchmarkPure_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Pure", "All")
+}
+
+func BenchmarkServeMux_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "ServeMux", "All")
+}
+
+func BenchmarkVestigo_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Vestigo", "All")
+}
+
+func BenchmarkVulcandRoute_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "VulcandRoute", "All")
+}
+
+func BenchmarkWay_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "Way", "All")
+}
+
+func BenchmarkXujiajunGorouter_StaticAll(b *testing.B) {
+	benchScenario(b, "Static", "XujiajunGorouter", "All")
+}
@@ -5,43 +5,92 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"net/http"
-	"os"
 	"regexp"
 	"runtime"
-	"strings"
 	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/scenarios"
 )
 
-var benchRe *regexp.Regexp
-
-func isTested(name string) bool {
-	if benchRe == nil {
-		// Get -test.bench flag value (not accessible via flag package)
-		bench := ""
-		for _, arg := range os.Args {
-			if strings.HasPrefix(arg, "-test.bench=") {
-				// ignore the benchmark name after an underscore
-				bench = strings.SplitN(arg[12:], "_", 2)[0]
-				break
-			}
-		}
+// all benchmarked routers, sorted by name
+var routers = adapters.Routers()
+
+// benchRes holds the regular expressions of the slash-separated parts of the
+// -test.bench flag. It is nil if no benchmarks are run.
+var benchRes []*regexp.Regexp
 
-		// Compile RegExp to match Benchmark names
-		var err error
-		benchRe, err = regexp.Compile(bench)
+// parseBenchFlag compiles the -test.bench flag into benchRes. It must be called
+// after the flags are parsed.
+func parseBenchFlag() error {
+	bench := flag.Lookup("test.bench").Value.String()
+	if bench == "" {
+		return nil
+	}
+	for _, part := range splitRegexp(bench) {
+		re, err := regexp.Compile(part)
 		if err != nil {
-			panic(err.Error())
+			return fmt.Errorf("invalid -test.bench: %v", err)
 		}
+		benchRes = append(benchRes, re)
 	}
-	return benchRe.MatchString(name)
+	return nil
 }
 
-func calcMem(name string, load func()) {
-	if !isTested(name) {
-		return
+// splitRegexp splits a regular expression at the slashes, which are not part
+// of a character class or a group, like the testing package does.
+func splitRegexp(s string) []string {
+	var parts []string
+	class := false // inside a character class
+	groups := 0    // number of open groups
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			class = true
+		case ']':
+			class = false
+		case '(':
+			if !class {
+				groups++
+			}
+		case ')':
+			if !class {
+				groups--
+			}
+		case '/':
+			if !class && groups == 0 {
+				parts = append(parts, s[:i])
+				s = s[i+1:]
+				i = -1
+			}
+		}
+	}
+	return append(parts, s)
+}
+
+// isTested reports whether a benchmark might be run, given the elements of its
+// name, e.g. isTested("BenchmarkAPI", "Github", "Gin"). Like in the testing
+// package, each element is matched against the respective slash-separated part
+// of the -test.bench flag.
+func isTested(names ...string) bool {
+	if benchRes == nil {
+		return false
+	}
+	for i, name := range names {
+		if i < len(benchRes) && !benchRes[i].MatchString(name) {
+			return false
+		}
 	}
+	return true
+}
 
+func calcMem(name string, load func()) {
 	m := new(runtime.MemStats)
 
 	// before
@@ -66,266 +115,279 @@ func calcMem(name string, load func()) {
 	println("   "+name+":", after-before, "Bytes")
 }
 
-func benchRequest(b *testing.B, router http.Handler, r *http.Request) {
+// checkResponse reports an error if the router responded with another status
+// than 200 OK or wrote another number of bytes than the handlers, e.g. because
+// of an automatic redirect. The handlers wrote want bytes per op.
+func checkResponse(b *testing.B, w *mockResponseWriter, want int) {
+	if w.status != 0 && w.status != http.StatusOK {
+		b.Errorf("router responded with status %d", w.status)
+	}
+	if w.written != int64(want)*int64(b.N) {
+		b.Errorf("router wrote %d bytes in %d ops, expected %d bytes per op", w.written, b.N, want)
+	}
+	if w.flushes > 0 || w.hijacks > 0 {
+		b.Logf("router flushed %d times and tried to hijack %d times", w.flushes, w.hijacks)
+	}
+}
+
+// benchRequest benchmarks a request to the route and returns the response
+// writer, which recorded the responses.
+func benchRequest(b *testing.B, router http.Handler, route fixtures.Route) *mockResponseWriter {
 	w := new(mockResponseWriter)
-	u := r.URL
-	rq := u.RawQuery
-	r.RequestURI = u.RequestURI()
+	src := newRequestSource([]fixtures.Route{route})
 
 	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		u.RawQuery = rq
+	for loop := benchLoop(b); loop(); {
+		r := src.request(0)
 		router.ServeHTTP(w, r)
+		src.release(r)
 	}
+	return w
 }
 
-func benchRoutes(b *testing.B, router http.Handler, routes []route) {
+// benchRoutes benchmarks a request to each of the routes per op and returns
+// the response writer, which recorded the responses.
+func benchRoutes(b *testing.B, router http.Handler, routes []fixtures.Route) *mockResponseWriter {
 	w := new(mockResponseWriter)
-	r, _ := http.NewRequest("GET", "/", nil)
-	u := r.URL
-	rq := u.RawQuery
+	src := newRequestSource(routes)
 
 	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		for _, route := range routes {
-			r.Method = route.method
-			r.RequestURI = route.path
-			u.Path = route.path
-			u.RawQuery = rq
+	for loop := benchLoop(b); loop(); {
+		for j := range routes {
+			r := src.request(j)
 			router.ServeHTTP(w, r)
+			src.release(r)
 		}
 	}
+	return w
 }
 
-// Micro Benchmarks
+// scenarioRouters holds the routers loaded with the routes of each scenario,
+// keyed by scenario and router name.
+var scenarioRouters = make(map[string]map[string]http.Handler)
 
-// Route with Param (no write)
-
-func BenchmarkBeego_Param(b *testing.B) {
-	router := loadBeegoSingle("GET", "/user/:name", beegoHandler)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// scenarioRequests returns the requests of a scenario, including the request
+// corpus if one was generated.
+func scenarioRequests(s scenarios.Scenario) []scenarios.Request {
+	requests := s.Requests()
+	if corpus, ok := corpora[s.Name()]; ok {
+		requests = append(requests[:len(requests):len(requests)], scenarios.Sequence(corpusRequest, corpus))
+	}
+	return requests
 }
 
-func BenchmarkChi_Param(b *testing.B) {
-	router := loadChiSingle("GET", "/user/{name}", httpHandlerFunc)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// scenarioTested reports whether any benchmark of the router in the scenario
+// might be run, including the generated flat benchmarks.
+func scenarioTested(s scenarios.Scenario, router string) bool {
+	if isTested("BenchmarkAPI", s.Name(), router) {
+		return true
+	}
+	for _, req := range scenarioRequests(s) {
+		if isTested(flatBenchmarkName(router, s.Name()+req.Name)) {
+			return true
+		}
+	}
+	return false
 }
 
-func BenchmarkEcho_Param(b *testing.B) {
-	router := loadEchoSingle("GET", "/user/:name", echoHandler)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// loadScenarios loads the routes of every registered scenario into every
+// tested router and prints the memory required for it, followed by the
+// statistics of the tree of the router and its caveats for the routes.
+// Routers which do not support the routes of a scenario are skipped, as are
+// routers panicking while loading them.
+func loadScenarios() {
+	for _, s := range selectedScenarios() {
+		handlers := make(map[string]http.Handler, len(routers))
+		tested := false
+		for _, router := range routers {
+			if !scenarioTested(s, router.Name) {
+				continue
+			}
+			if !tested {
+				println("#"+s.Name()+" Routes:", len(s.Routes()))
+				tested = true
+			}
+			if err := router.Capabilities.Check(s.Routes()); err != nil {
+				println("   "+router.Name+": skipped,", err.Error())
+				continue
+			}
+			if !router.Capabilities.Supports(s.HandlerKind()) {
+				println("   " + router.Name + ": skipped, handler not supported")
+				continue
+			}
+			var err error
+			calcMem(router.Name, func() {
+				handlers[router.Name], err = tryLoad(router, s.Routes(), s.HandlerKind())
+			})
+			if err != nil {
+				delete(handlers, router.Name)
+				println("   "+router.Name+": unsupported,", err.Error())
+				continue
+			}
+			if stats, ok := router.Tree(handlers[router.Name]); ok {
+				println("   " + router.Name + ": tree, " + fmt.Sprintf("%d nodes, depth %d, fan-out %.2f", stats.Nodes, stats.Depth, stats.FanOut))
+			}
+			printCaveats(router.Name, conformance(router, s.Routes()))
+		}
+		if tested {
+			scenarioRouters[s.Name()] = handlers
+			println()
+		}
+	}
 }
-func BenchmarkGin_Param(b *testing.B) {
-	router := loadGinSingle("GET", "/user/:name", ginHandle)
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// tryLoad loads the routes into the router and returns the panic of the router
+// as an error, e.g. for conflicting routes.
+func tryLoad(router adapters.Router, routes []fixtures.Route, kind adapters.HandlerKind) (h http.Handler, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("panic: %v", v)
+		}
+	}()
+	return router.Load(routes, kind), nil
 }
 
-func BenchmarkHttpRouter_Param(b *testing.B) {
-	router := loadHttpRouterSingle("GET", "/user/:name", httpRouterHandle)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// benchScenarioRequest benchmarks a request of a scenario.
+func benchScenarioRequest(b *testing.B, router http.Handler, kind adapters.HandlerKind, req scenarios.Request) {
+	var w *mockResponseWriter
+	if len(req.Routes) == 1 {
+		w = benchRequest(b, router, req.Routes[0])
+	} else {
+		w = benchRoutes(b, router, req.Routes)
+	}
+	// the misses of the corpus are responded with 404 Not Found
+	misses := req.Name == corpusRequest && corpusConfig.MissRatio > 0
+	if kind == adapters.HandlerEmpty && !misses {
+		checkResponse(b, w, 0)
+	}
 }
 
-func BenchmarkMacaron_Param(b *testing.B) {
-	router := loadMacaronSingle("GET", "/user/:name", macaronHandler)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// benchScenario benchmarks a single request of a scenario against a router.
+// It is used by the generated flat benchmarks.
+func benchScenario(b *testing.B, scenario, router, request string) {
+	h, ok := scenarioRouters[scenario][router]
+	if !ok {
+		b.Skipf("%s is not loaded with the routes of %s", router, scenario)
+	}
+	for _, s := range selectedScenarios() {
+		if s.Name() != scenario {
+			continue
+		}
+		for _, req := range scenarioRequests(s) {
+			if req.Name == request {
+				for _, r := range routers {
+					if r.Name == router {
+						verifyScenarioRequest(b, r, s, req)
+					}
+				}
+				benchScenarioRequest(b, h, s.HandlerKind(), req)
+				return
+			}
+		}
+	}
+	b.Skipf("%s has no request %s", scenario, request)
 }
 
-// func BenchmarkRevel_Param(b *testing.B) {
-// 	router := loadRevelSingle("GET", "/user/:name", "RevelController.Handle")
+// BenchmarkAPI runs the requests of every registered scenario against every
+// router and the baseline, named BenchmarkAPI/<Scenario>/<Router>/<Request>.
+func BenchmarkAPI(b *testing.B) {
+	for _, s := range selectedScenarios() {
+		handlers := scenarioRouters[s.Name()]
+		requests := scenarioRequests(s)
+		b.Run(s.Name(), func(b *testing.B) {
+			for _, router := range routers {
+				h, ok := handlers[router.Name]
+				if !ok {
+					continue
+				}
+				b.Run(router.Name, func(b *testing.B) {
+					for _, req := range requests {
+						b.Run(req.Name, func(b *testing.B) {
+							verifyScenarioRequest(b, router, s, req)
+							benchScenarioRequest(b, h, s.HandlerKind(), req)
+						})
+					}
+				})
+			}
+			benchBaseline(b, s, requests)
+		})
+	}
+}
 
-// 	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-// 	benchRequest(b, router, r)
-// }
+// Micro Benchmarks
 
-// Route with 5 Params (no write)
+// Route with 5 Params
 const fiveColon = "/:a/:b/:c/:d/:e"
-const fiveBrace = "/{a}/{b}/{c}/{d}/{e}"
 const fiveRoute = "/test/test/test/test/test"
 
-func BenchmarkBeego_Param5(b *testing.B) {
-	router := loadBeegoSingle("GET", fiveColon, beegoHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkChi_Param5(b *testing.B) {
-	router := loadChiSingle("GET", fiveBrace, httpHandlerFunc)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkEcho_Param5(b *testing.B) {
-	router := loadEchoSingle("GET", fiveColon, echoHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGin_Param5(b *testing.B) {
-	router := loadGinSingle("GET", fiveColon, ginHandle)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkGorillaMux_Param5(b *testing.B) {
-	router := loadGorillaMuxSingle("GET", fiveBrace, httpHandlerFunc)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkHttpRouter_Param5(b *testing.B) {
-	router := loadHttpRouterSingle("GET", fiveColon, httpRouterHandle)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkMacaron_Param5(b *testing.B) {
-	router := loadMacaronSingle("GET", fiveColon, macaronHandler)
-
-	r, _ := http.NewRequest("GET", fiveRoute, nil)
-	benchRequest(b, router, r)
-}
-
-// func BenchmarkRevel_Param5(b *testing.B) {
-// 	router := loadRevelSingle("GET", fiveColon, "RevelController.Handle")
-
-// 	r, _ := http.NewRequest("GET", fiveRoute, nil)
-// 	benchRequest(b, router, r)
-// }
-
-// Route with 20 Params (no write)
+// Route with 20 Params
 const twentyColon = "/:a/:b/:c/:d/:e/:f/:g/:h/:i/:j/:k/:l/:m/:n/:o/:p/:q/:r/:s/:t"
-const twentyBrace = "/{a}/{b}/{c}/{d}/{e}/{f}/{g}/{h}/{i}/{j}/{k}/{l}/{m}/{n}/{o}/{p}/{q}/{r}/{s}/{t}"
 const twentyRoute = "/a/b/c/d/e/f/g/h/i/j/k/l/m/n/o/p/q/r/s/t"
 
-func BenchmarkBeego_Param20(b *testing.B) {
-	router := loadBeegoSingle("GET", twentyColon, beegoHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkChi_Param20(b *testing.B) {
-	router := loadChiSingle("GET", twentyBrace, httpHandlerFunc)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkEcho_Param20(b *testing.B) {
-	router := loadEchoSingle("GET", twentyColon, echoHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGin_Param20(b *testing.B) {
-	router := loadGinSingle("GET", twentyColon, ginHandle)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkGorillaMux_Param20(b *testing.B) {
-	router := loadGorillaMuxSingle("GET", twentyBrace, httpHandlerFunc)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkHttpRouter_Param20(b *testing.B) {
-	router := loadHttpRouterSingle("GET", twentyColon, httpRouterHandle)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
+var microBenchmarks = []struct {
+	name    string
+	path    string
+	request string
+	kind    adapters.HandlerKind
+	body    string // written by the handler
+}{
+	// Route with Param (no write)
+	{"Param", "/user/:name", "/user/gordon", adapters.HandlerEmpty, ""},
+	// Route with 5 Params (no write)
+	{"Param5", fiveColon, fiveRoute, adapters.HandlerEmpty, ""},
+	// Route with 20 Params (no write)
+	{"Param20", twentyColon, twentyRoute, adapters.HandlerEmpty, ""},
+	// Route with Param and write
+	{"ParamWrite", "/user/:name", "/user/gordon", adapters.HandlerWrite, "gordon"},
+	// Route with Param, read from the request context, and write
+	{"ParamContextWrite", "/user/:name", "/user/gordon", adapters.HandlerContextWrite, "gordon"},
 }
 
-func BenchmarkMacaron_Param20(b *testing.B) {
-	router := loadMacaronSingle("GET", twentyColon, macaronHandler)
-
-	r, _ := http.NewRequest("GET", twentyRoute, nil)
-	benchRequest(b, router, r)
-}
-
-// func BenchmarkRevel_Param20(b *testing.B) {
-// 	router := loadRevelSingle("GET", twentyColon, "RevelController.Handle")
-
-// 	r, _ := http.NewRequest("GET", twentyRoute, nil)
-// 	benchRequest(b, router, r)
-// }
-
-// Route with Param and write
-
-func BenchmarkBeego_ParamWrite(b *testing.B) {
-	router := loadBeegoSingle("GET", "/user/:name", beegoHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkChi_ParamWrite(b *testing.B) {
-	router := loadChiSingle("GET", "/user/{name}", chiHandleWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkEcho_ParamWrite(b *testing.B) {
-	router := loadEchoSingle("GET", "/user/:name", echoHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-func BenchmarkGin_ParamWrite(b *testing.B) {
-	router := loadGinSingle("GET", "/user/:name", ginHandleWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
-}
-
-func BenchmarkGorillaMux_ParamWrite(b *testing.B) {
-	router := loadGorillaMuxSingle("GET", "/user/{name}", gorillaHandlerWrite)
+// benchMicro runs a micro benchmark against a router.
+func benchMicro(b *testing.B, router adapters.Router, name string) {
+	for _, bm := range microBenchmarks {
+		if bm.name == name {
+			if err := router.Capabilities.Check([]fixtures.Route{{Method: "GET", Path: bm.path}}); err != nil {
+				b.Skip(err)
+			}
+			if !router.Capabilities.Supports(bm.kind) {
+				b.Skipf("%s does not provide the params in the request context", router.Name)
+			}
+			if *verifyFlag {
+				b.StopTimer()
+				h := router.LoadSingle("GET", bm.path, adapters.HandlerParams)
+				verifyRequests(b, h, []fixtures.Route{{Method: "GET", Path: bm.path}}, []fixtures.Route{{Method: "GET", Path: bm.request}})
+			}
+			h := router.LoadSingle("GET", bm.path, bm.kind)
 
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+			w := benchRequest(b, h, fixtures.Route{Method: "GET", Path: bm.request})
+			checkResponse(b, w, len(bm.body))
+			return
+		}
+	}
+	b.Skipf("unknown micro benchmark %s", name)
 }
 
-func BenchmarkHttpRouter_ParamWrite(b *testing.B) {
-	router := loadHttpRouterSingle("GET", "/user/:name", httpRouterHandleWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+func BenchmarkMicro(b *testing.B) {
+	for _, router := range routers {
+		b.Run(router.Name, func(b *testing.B) {
+			for _, bm := range microBenchmarks {
+				b.Run(bm.name, func(b *testing.B) {
+					benchMicro(b, router, bm.name)
+				})
+			}
+		})
+	}
 }
 
-func BenchmarkMacaron_ParamWrite(b *testing.B) {
-	router := loadMacaronSingle("GET", "/user/:name", macaronHandlerWrite)
-
-	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-	benchRequest(b, router, r)
+// benchMicroRouter runs a micro benchmark against a router given by name.
+// It is used by the generated flat benchmarks.
+func benchMicroRouter(b *testing.B, router, name string) {
+	for _, r := range routers {
+		if r.Name == router {
+			benchMicro(b, r, name)
+			return
+		}
+	}
+	b.Skipf("unknown router %s", router)
 }
-
-// func BenchmarkRevel_ParamWrite(b *testing.B) {
-// 	router := loadRevelSingle("GET", "/user/:name", "RevelController.HandleWrite")
-
-// 	r, _ := http.NewRequest("GET", "/user/gordon", nil)
-// 	benchRequest(b, router, r)
-// }
@@ -0,0 +1,242 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package adapters loads routes into the benchmarked routers.
+//
+// Every router registers itself in the package's registry, which can be used
+// to run benchmarks or conformance tests against all routers:
+//
+//	for _, r := range adapters.Routers() {
+//		h := r.Load(fixtures.GithubAPI, adapters.HandlerEmpty)
+//		...
+//	}
+package adapters
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+// If you add new routers please:
+// - Put the handlers and loaders in a new <name>.go file, or in a new
+//   <name>adapter module if the router pulls in a framework with further
+//   dependencies or needs versions that clash with the main module (see
+//   ginadapter); small router packages stay in the main module
+// - Register the router in an init function of that file, declaring the
+//   Capabilities of the router
+// - Provide a handler for each HandlerKind, the TestConformance test checks
+//   the params written by the HandlerParams handlers and the TestMethodDispatch
+//   test the methods written by the HandlerMethod handlers
+// - Optionally pass a Configure function to support variants of the router
+// - Optionally set the TreeNode type, if the router stores its routes in a tree
+// - Optionally register an older major version of the router with its own
+//   module path as <Name>V<major> next to it, e.g. ChiV4 or EchoV3, to compare
+//   the versions side by side
+// - Make a pull request (without benchmark results) at
+//   https://github.com/julienschmidt/go-http-routing-benchmark
+
+// HandlerKind selects the handler variant registered by the loaders.
+type HandlerKind int
+
+const (
+	HandlerEmpty        HandlerKind = iota // does nothing
+	HandlerWrite                           // writes the "name" param
+	HandlerTest                            // writes the request URI
+	HandlerContextWrite                    // writes the "name" param, read from the request context
+	HandlerParams                          // writes all params with WriteParams
+	HandlerMethod                          // writes the method of the route, see MethodHandler
+)
+
+// Router is a benchmarked router.
+type Router struct {
+	Name string
+
+	// Module is the path of the module providing the router, which is used to
+	// tell versions of the router apart.
+	Module string
+
+	// Capabilities describe the routes the router supports.
+	Capabilities Capabilities
+
+	// Load returns the router with all routes registered.
+	Load func(routes []fixtures.Route, kind HandlerKind) http.Handler
+
+	// LoadSingle returns the router with a single route registered.
+	LoadSingle func(method, path string, kind HandlerKind) http.Handler
+
+	// Configure returns the loaders of a variant of the router with the given
+	// options. It is nil if the router has no options.
+	Configure func(opts Options) (Router, error)
+
+	// TreeNode is the type of the nodes of the tree of the router, e.g.
+	// "httprouter.node", for the statistics of Tree. It is empty if the
+	// router has no tree.
+	TreeNode string
+}
+
+// Options are the router-specific options of a variant of a router, keyed by
+// option name, e.g. {"RedirectTrailingSlash": false} for HttpRouter.
+type Options map[string]interface{}
+
+// Variant returns a variant of the router with the given options applied,
+// which is benchmarked as a distinct router with the given name.
+func (r Router) Variant(name string, opts Options) (Router, error) {
+	if r.Configure == nil {
+		return Router{}, fmt.Errorf("%s has no options", r.Name)
+	}
+	v, err := r.Configure(opts)
+	if err != nil {
+		return Router{}, fmt.Errorf("%s: %v", r.Name, err)
+	}
+	v.Name = name
+	v.Module = r.Module
+	v.Capabilities = r.Capabilities
+	v.Configure = nil
+	v.TreeNode = r.TreeNode
+	return translated(v), nil
+}
+
+// Check returns an error if opts contain an option not in names.
+func (opts Options) Check(names ...string) error {
+	for name := range opts {
+		known := false
+		for _, n := range names {
+			if n == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown option %q, known options: %s", name, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// Bool returns the boolean option name, or def if it is not set.
+func (opts Options) Bool(name string, def bool) (bool, error) {
+	v, ok := opts[name]
+	if !ok {
+		return def, nil
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("option %s: %v is not a boolean", name, v)
+	}
+	return b, nil
+}
+
+// routers is the registry of all routers, sorted by name.
+var routers []Router
+
+// Routers returns all registered routers, sorted by name.
+// The loaders of the returned routers take paths in the canonical colon syntax
+// (/user/:name) of the fixtures.
+func Routers() []Router {
+	return append([]Router(nil), routers...)
+}
+
+// Register adds a router to the registry. It must be called from an init
+// function of the router's file or package.
+// The loaders of the router get paths in the router's dialect. They are
+// wrapped, so that the loaders in the registry take paths in the canonical
+// colon syntax instead.
+func Register(r Router) {
+	i := sort.Search(len(routers), func(i int) bool {
+		return routers[i].Name >= r.Name
+	})
+	if i < len(routers) && routers[i].Name == r.Name {
+		panic("router registered twice: " + r.Name)
+	}
+
+	routers = append(routers, Router{})
+	copy(routers[i+1:], routers[i:])
+	routers[i] = translated(r)
+}
+
+// translated wraps the loaders of a router, which take paths in the router's
+// dialect, in loaders taking paths in the canonical colon syntax.
+func translated(r Router) Router {
+	load, loadSingle := r.Load, r.LoadSingle
+	r.Load = func(routes []fixtures.Route, kind HandlerKind) http.Handler {
+		translated := make([]fixtures.Route, len(routes))
+		for i, route := range routes {
+			translated[i] = fixtures.Route{
+				Method: route.Method,
+				Path:   pathsyntax.Translate(route.Path, r.Capabilities.Dialect),
+			}
+		}
+		return load(translated, kind)
+	}
+	r.LoadSingle = func(method, path string, kind HandlerKind) http.Handler {
+		return loadSingle(method, pathsyntax.Translate(path, r.Capabilities.Dialect), kind)
+	}
+	return r
+}
+
+// Common
+func httpHandlerFunc(_ http.ResponseWriter, _ *http.Request) {}
+
+func httpHandlerFuncTest(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.RequestURI)
+}
+
+// MethodHandler returns the HandlerMethod handler of a route with the method,
+// which writes the method the route is registered for. Routers registering a
+// route for other methods as well, e.g. as Any route, thus write a different
+// method than the method of the request.
+func MethodHandler(method string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, method)
+	}
+}
+
+// WriteParams writes the params of a request for the HandlerParams handlers,
+// as a name=value line per param, sorted by name.
+func WriteParams(w io.Writer, params map[string]string) {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		io.WriteString(w, name+"="+params[name]+"\n")
+	}
+}
+
+// ParamNames returns the names of the params of a route path in the dialect,
+// for the HandlerParams handlers of routers which only provide the params by
+// their names.
+func ParamNames(path string, d pathsyntax.Dialect) []string {
+	var names []string
+	canonical, _ := pathsyntax.Parse(path, d)
+	for _, seg := range strings.Split(canonical, "/") {
+		if isParam(seg) {
+			names = append(names, seg[1:])
+		}
+	}
+	return names
+}
+
+// EscapePath escapes the non-ASCII bytes and percent signs of a route path like
+// the path of a request is escaped, e.g. /caf%C3%A9 for /café, for routers
+// matching the escaped paths of the requests. The syntax of the params of the
+// routers is kept.
+func EscapePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if c := path[i]; c == '%' || c <= ' ' || c >= 0x7f {
+			fmt.Fprintf(&b, "%%%02X", c)
+			continue
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
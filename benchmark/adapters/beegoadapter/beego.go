@@ -0,0 +1,144 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package beegoadapter registers Beego in the adapters registry when imported.
+// It is a module of its own, so that Beego and its dependencies are only
+// downloaded if it is benchmarked.
+package beegoadapter
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/beego/beego/v2/core/logs"
+	"github.com/beego/beego/v2/server/web"
+	"github.com/beego/beego/v2/server/web/context"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	initBeego()
+
+	adapters.Register(adapters.Router{
+		Name:   "Beego",
+		Module: "github.com/beego/beego/v2",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.ColonStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadBeego,
+		LoadSingle: loadBeegoSingle,
+	})
+}
+
+func beegoHandler(ctx *context.Context) {}
+
+func beegoHandlerWrite(ctx *context.Context) {
+	ctx.WriteString(ctx.Input.Param(":name"))
+}
+
+func beegoHandlerTest(ctx *context.Context) {
+	ctx.WriteString(ctx.Request.RequestURI)
+}
+
+// beegoHandlerParams returns the HandlerParams handler of a route, which has a
+// catch-all param if catchAll is set.
+func beegoHandlerParams(catchAll bool) web.HandleFunc {
+	return func(ctx *context.Context) {
+		params := make(map[string]string)
+		for name, value := range ctx.Input.Params() {
+			name = strings.TrimPrefix(name, ":")
+			// beego also provides the segments of a catch-all param by their
+			// position, i.e. 0, 1, ...
+			if _, err := strconv.Atoi(name); err == nil {
+				continue
+			}
+			// the root of the filter chains of beego v2 matches every path
+			// as /*, whose splat param is only overwritten by a catch-all
+			// param of the route
+			if name == "splat" && !catchAll {
+				continue
+			}
+			params[name] = value
+		}
+		adapters.WriteParams(ctx.ResponseWriter, params)
+	}
+}
+
+func beegoHandlerMethod(method string) web.HandleFunc {
+	return func(ctx *context.Context) {
+		ctx.WriteString(method)
+	}
+}
+
+func beegoHandlerFor(path string, kind adapters.HandlerKind) web.HandleFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return beegoHandlerWrite
+	case adapters.HandlerTest:
+		return beegoHandlerTest
+	case adapters.HandlerParams:
+		return beegoHandlerParams(strings.Contains(path, "*"))
+	}
+	return beegoHandler
+}
+
+func initBeego() {
+	web.BConfig.RunMode = web.PROD
+	logs.GetBeeLogger().Close()
+}
+
+func loadBeego(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	app := web.NewControllerRegister()
+	for _, route := range routes {
+		h := beegoHandlerFor(route.Path, kind)
+		if kind == adapters.HandlerMethod {
+			h = beegoHandlerMethod(route.Method)
+		}
+		switch route.Method {
+		case "GET":
+			app.Get(route.Path, h)
+		case "POST":
+			app.Post(route.Path, h)
+		case "PUT":
+			app.Put(route.Path, h)
+		case "PATCH":
+			app.Patch(route.Path, h)
+		case "DELETE":
+			app.Delete(route.Path, h)
+		default:
+			panic("Unknow HTTP method: " + route.Method)
+		}
+	}
+	return app
+}
+
+func loadBeegoSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	handler := beegoHandlerFor(path, kind)
+	if kind == adapters.HandlerMethod {
+		handler = beegoHandlerMethod(method)
+	}
+
+	app := web.NewControllerRegister()
+	switch method {
+	case "GET":
+		app.Get(path, handler)
+	case "POST":
+		app.Post(path, handler)
+	case "PUT":
+		app.Put(path, handler)
+	case "PATCH":
+		app.Patch(path, handler)
+	case "DELETE":
+		app.Delete(path, handler)
+	default:
+		panic("Unknow HTTP method: " + method)
+	}
+	return app
+}
@@ -0,0 +1,52 @@
+module github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/beegoadapter
+
+go 1.20
+
+require (
+	github.com/beego/beego/v2 v2.3.8
+	github.com/julienschmidt/go-http-routing-benchmark v0.0.0-00010101000000-000000000000
+)
+
+require (
+	github.com/ant0ine/go-json-rest v3.3.2+incompatible // indirect
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/bmizerany/pat v0.0.0-20210406213842-e4b6760bdd6f // indirect
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/codegangsta/inject v0.0.0-20150114235600-33e0aa1cb7c0 // indirect
+	github.com/dimfeld/httptreemux/v5 v5.5.0 // indirect
+	github.com/emicklei/go-restful/v3 v3.13.0 // indirect
+	github.com/go-chi/chi v4.0.2+incompatible // indirect
+	github.com/go-chi/chi/v5 v5.2.0 // indirect
+	github.com/go-martini/martini v0.0.0-20170121215854-22fa46961aab // indirect
+	github.com/go-ozzo/ozzo-routing v2.1.4+incompatible // indirect
+	github.com/go-playground/form v3.1.4+incompatible // indirect
+	github.com/go-playground/pkg v0.0.0-20190513234448-af45a46936e9 // indirect
+	github.com/go-playground/pure v0.0.0-20190513234712-ab95fef1be7a // indirect
+	github.com/gocraft/web v0.0.0-20190207150652-9707327fb69b // indirect
+	github.com/gorilla/mux v1.7.3 // indirect
+	github.com/hashicorp/golang-lru v0.5.4 // indirect
+	github.com/husobee/vestigo v1.1.1 // indirect
+	github.com/julienschmidt/httprouter v1.3.0 // indirect
+	github.com/kataras/muxie v1.1.2 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/matryer/way v0.0.0-20180416093233-9632d0c407b0 // indirect
+	github.com/mitchellh/mapstructure v1.5.0 // indirect
+	github.com/prometheus/client_golang v1.19.0 // indirect
+	github.com/prometheus/client_model v0.5.0 // indirect
+	github.com/prometheus/common v0.48.0 // indirect
+	github.com/prometheus/procfs v0.12.0 // indirect
+	github.com/shiena/ansicolor v0.0.0-20200904210342-c7312218db18 // indirect
+	github.com/urfave/negroni v1.0.0 // indirect
+	github.com/xujiajun/gorouter v1.2.0 // indirect
+	github.com/zenazn/goji v1.0.1 // indirect
+	goji.io/v3 v3.0.0 // indirect
+	golang.org/x/crypto v0.24.0 // indirect
+	golang.org/x/net v0.23.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
+	google.golang.org/protobuf v1.34.2 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/julienschmidt/go-http-routing-benchmark => ../../..
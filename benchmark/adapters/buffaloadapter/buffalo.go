@@ -0,0 +1,135 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package buffaloadapter registers Buffalo in the adapters registry when
+// imported. It is a module of its own, so that Buffalo and its dependencies are
+// only downloaded if it is benchmarked.
+package buffaloadapter
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gobuffalo/buffalo"
+	"github.com/gobuffalo/logger"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "Buffalo",
+		Module: "github.com/gobuffalo/buffalo",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Brace,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadBuffalo,
+		LoadSingle: loadBuffaloSingle,
+	})
+}
+
+func buffaloHandler(_ buffalo.Context) error {
+	return nil
+}
+
+func buffaloHandlerWrite(c buffalo.Context) error {
+	_, err := io.WriteString(c.Response(), c.Param("name"))
+	return err
+}
+
+func buffaloHandlerTest(c buffalo.Context) error {
+	_, err := io.WriteString(c.Response(), c.Request().RequestURI)
+	return err
+}
+
+// buffaloHandlerParams reads the params from gorilla/mux, which Buffalo routes
+// with, as the params of the context also contain the query and form values.
+func buffaloHandlerParams(c buffalo.Context) error {
+	adapters.WriteParams(c.Response(), mux.Vars(c.Request()))
+	return nil
+}
+
+// buffaloHandlerContextWrite is an http.HandlerFunc, for which gorilla/mux
+// stores the params in the request context.
+func buffaloHandlerContextWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, mux.Vars(r)["name"])
+}
+
+func buffaloHandlerMethod(method string) buffalo.Handler {
+	return func(c buffalo.Context) error {
+		_, err := io.WriteString(c.Response(), method)
+		return err
+	}
+}
+
+func buffaloHandlerFor(method string, kind adapters.HandlerKind) buffalo.Handler {
+	switch kind {
+	case adapters.HandlerWrite:
+		return buffaloHandlerWrite
+	case adapters.HandlerTest:
+		return buffaloHandlerTest
+	case adapters.HandlerContextWrite:
+		return buffalo.WrapHandlerFunc(buffaloHandlerContextWrite)
+	case adapters.HandlerParams:
+		return buffaloHandlerParams
+	case adapters.HandlerMethod:
+		return buffaloHandlerMethod(method)
+	}
+	return buffaloHandler
+}
+
+// newBuffalo returns an application without middlewares, whose logger discards
+// the log of the errors, e.g. for paths which are not found. It runs in the
+// test environment, which skips the error pages of the development
+// environment and has a session secret, as Buffalo opens a session for every
+// request.
+func newBuffalo() *buffalo.App {
+	log := logrus.New()
+	log.Out = io.Discard
+
+	app := buffalo.New(buffalo.Options{
+		Env:    "test",
+		Logger: logger.Logrus{FieldLogger: log},
+	})
+	app.Middleware.Clear()
+	return app
+}
+
+func buffaloHandle(app *buffalo.App, method, path string, h buffalo.Handler) {
+	switch method {
+	case "GET":
+		app.GET(path, h)
+	case "POST":
+		app.POST(path, h)
+	case "PUT":
+		app.PUT(path, h)
+	case "PATCH":
+		app.PATCH(path, h)
+	case "DELETE":
+		app.DELETE(path, h)
+	default:
+		panic("Unknow HTTP method: " + method)
+	}
+}
+
+func loadBuffalo(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	app := newBuffalo()
+	for _, route := range routes {
+		buffaloHandle(app, route.Method, route.Path, buffaloHandlerFor(route.Method, kind))
+	}
+	return app
+}
+
+func loadBuffaloSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	app := newBuffalo()
+	buffaloHandle(app, method, path, buffaloHandlerFor(method, kind))
+	return app
+}
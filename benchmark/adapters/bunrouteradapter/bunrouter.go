@@ -0,0 +1,97 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package bunrouteradapter registers BunRouter in the adapters registry when
+// imported. It is a module of its own, as BunRouter requires Go 1.22, while
+// the main module supports Go 1.13.
+package bunrouteradapter
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/uptrace/bunrouter"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "BunRouter",
+		Module: "github.com/uptrace/bunrouter",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Colon,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadBunRouter,
+		LoadSingle: loadBunRouterSingle,
+		TreeNode:   "bunrouter.node",
+	})
+}
+
+func bunRouterHandler(_ http.ResponseWriter, _ bunrouter.Request) error {
+	return nil
+}
+
+func bunRouterHandlerWrite(w http.ResponseWriter, r bunrouter.Request) error {
+	_, err := io.WriteString(w, r.Param("name"))
+	return err
+}
+
+func bunRouterHandlerTest(w http.ResponseWriter, r bunrouter.Request) error {
+	_, err := io.WriteString(w, r.RequestURI)
+	return err
+}
+
+func bunRouterHandlerParams(w http.ResponseWriter, r bunrouter.Request) error {
+	adapters.WriteParams(w, r.Params().Map())
+	return nil
+}
+
+// bunRouterHandlerContextWrite is an http.HandlerFunc, for which BunRouter
+// stores the params in the request context.
+func bunRouterHandlerContextWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, bunrouter.ParamsFromContext(r.Context()).ByName("name"))
+}
+
+func bunRouterHandlerMethod(method string) bunrouter.HandlerFunc {
+	return func(w http.ResponseWriter, _ bunrouter.Request) error {
+		_, err := io.WriteString(w, method)
+		return err
+	}
+}
+
+func bunRouterHandlerFor(method string, kind adapters.HandlerKind) bunrouter.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return bunRouterHandlerWrite
+	case adapters.HandlerTest:
+		return bunRouterHandlerTest
+	case adapters.HandlerContextWrite:
+		return bunrouter.HTTPHandlerFunc(bunRouterHandlerContextWrite)
+	case adapters.HandlerParams:
+		return bunRouterHandlerParams
+	case adapters.HandlerMethod:
+		return bunRouterHandlerMethod(method)
+	}
+	return bunRouterHandler
+}
+
+func loadBunRouter(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	router := bunrouter.New()
+	for _, route := range routes {
+		router.Handle(route.Method, route.Path, bunRouterHandlerFor(route.Method, kind))
+	}
+	return router
+}
+
+func loadBunRouterSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	router := bunrouter.New()
+	router.Handle(method, path, bunRouterHandlerFor(method, kind))
+	return router
+}
@@ -0,0 +1,252 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+// Capabilities describe which routes a router supports. The harness skips the
+// benchmarks with routes a router does not support, instead of letting the
+// router panic when the routes are loaded.
+type Capabilities struct {
+	// Dialect is the path syntax of the router.
+	Dialect pathsyntax.Dialect
+
+	// CatchAll is set if the router supports catch-all params like
+	// /src/*filepath.
+	CatchAll bool
+
+	// ConflictingRoutes is set if the router supports routes which are
+	// ambiguous at the same path segment, like /user/new and /user/:name, or
+	// /user/:name and /user/:id/repos.
+	ConflictingRoutes bool
+
+	// MaxParams is the maximum number of params of a route, or 0 if the
+	// number is not limited.
+	MaxParams int
+
+	// IdentifierParams is set if the names of the params must be identifiers,
+	// i.e. a letter or underscore followed by letters, digits or underscores,
+	// like the wildcards of the ServeMux.
+	IdentifierParams bool
+
+	// CleanPaths is set if the routes must be clean paths without . and ..
+	// segments, like the patterns of the ServeMux.
+	CleanPaths bool
+
+	// PrefixRoutes is set if the router serves a request by the first route
+	// registered, whose path matches a prefix of the request path, like
+	// gorilla/pat. Thus the routes must be registered in an order, in which no
+	// route matches a prefix of the paths of a later one, e.g. /user/:name
+	// after /user/:name/repos. The harness skips the orders of the routes
+	// which are not supported, instead of reordering them.
+	PrefixRoutes bool
+
+	// SharedParamNames is set if the nodes, which a route adds to the tree of
+	// the router, share the names of its params, like in vestigo. Thus a route
+	// ending with a param, which is registered after a route of the same
+	// method extending its path with other params, e.g. /user/:id after
+	// /user/:name/repos, overwrites the names of the latter, if the same route
+	// added the nodes both of them end at. The harness skips the orders of the
+	// routes which are not supported, instead of reordering them.
+	SharedParamNames bool
+
+	// ContextParams is set if the params can be read from the context of the
+	// request, as by HandlerContextWrite handlers, besides the router's own
+	// accessors.
+	ContextParams bool
+}
+
+// Supports reports whether the router has handlers of the given kind.
+func (c Capabilities) Supports(kind HandlerKind) bool {
+	return kind != HandlerContextWrite || c.ContextParams
+}
+
+// Check returns an error describing the first route the capabilities do not
+// support, or nil if all routes are supported. The routes must be in the
+// canonical colon syntax.
+func (c Capabilities) Check(routes []fixtures.Route) error {
+	for _, route := range routes {
+		params := 0
+		for _, seg := range strings.Split(route.Path, "/") {
+			if c.CleanPaths && (seg == "." || seg == "..") {
+				return fmt.Errorf("unclean paths are not supported: %s %s", route.Method, route.Path)
+			}
+			if !isParam(seg) {
+				continue
+			}
+			params++
+			if seg[0] == '*' && !c.CatchAll {
+				return fmt.Errorf("catch-all params are not supported: %s %s", route.Method, route.Path)
+			}
+			if c.IdentifierParams && !isIdentifier(seg[1:]) {
+				return fmt.Errorf("param names which are not identifiers are not supported: %s %s", route.Method, route.Path)
+			}
+		}
+		if c.MaxParams > 0 && params > c.MaxParams {
+			return fmt.Errorf("more than %d params are not supported: %s %s", c.MaxParams, route.Method, route.Path)
+		}
+	}
+
+	if !c.ConflictingRoutes {
+		if a, b, ok := conflicting(routes); ok {
+			return fmt.Errorf("conflicting routes are not supported: %s %s and %s", a.Method, a.Path, b.Path)
+		}
+	}
+	if c.PrefixRoutes {
+		if a, b, ok := shadowed(routes); ok {
+			return fmt.Errorf("routes after a route matching a prefix of their paths are not supported: %s %s after %s", b.Method, b.Path, a.Path)
+		}
+	}
+	if c.SharedParamNames {
+		if a, b, ok := overwritten(routes); ok {
+			return fmt.Errorf("routes ending with a param after a route extending them with other params are not supported: %s %s after %s", b.Method, b.Path, a.Path)
+		}
+	}
+	return nil
+}
+
+// isParam reports whether a path segment is a param or catch-all param.
+func isParam(seg string) bool {
+	return len(seg) >= 2 && (seg[0] == ':' || seg[0] == '*')
+}
+
+// isIdentifier reports whether a param name is an identifier.
+func isIdentifier(name string) bool {
+	for i, c := range name {
+		if !unicode.IsLetter(c) && c != '_' && (i == 0 || !unicode.IsDigit(c)) {
+			return false
+		}
+	}
+	return name != ""
+}
+
+// shadowed returns the first route, whose sample path a prefix of an earlier
+// route of the same method matches, and the earlier route.
+func shadowed(routes []fixtures.Route) (a, b fixtures.Route, ok bool) {
+	for i, b := range routes {
+		path := fixtures.NewSample(b).Path
+		for _, a := range routes[:i] {
+			if a.Method == b.Method && matchesPrefix(a.Path, path) {
+				return a, b, true
+			}
+		}
+	}
+	return a, b, false
+}
+
+// overwritten returns the first route ending with a param, which overwrites
+// the names of the params of an earlier route of the same method extending its
+// path with other params, and the earlier route. The names are shared, if the
+// first route reaching the node the route ends at added the node of the
+// earlier route as well, i.e. if its path equals the path of the earlier route
+// or extends it after a param.
+func overwritten(routes []fixtures.Route) (a, b fixtures.Route, ok bool) {
+	for i, b := range routes {
+		segments := strings.Split(b.Path, "/")
+		if last := segments[len(segments)-1]; !isParam(last) || last[0] != ':' {
+			continue
+		}
+		bShape, bNames := paramShape(b.Path)
+		for _, a := range routes[:i] {
+			aShape, aNames := paramShape(a.Path)
+			if a.Method != b.Method || aNames == bNames || !strings.HasPrefix(aShape, bShape+"/") {
+				continue
+			}
+			for _, c := range routes[:i] {
+				cShape, _ := paramShape(c.Path)
+				if cShape != bShape && !strings.HasPrefix(cShape, bShape+"/") {
+					continue
+				}
+				if cShape == aShape || strings.HasSuffix(aShape, "/:") && strings.HasPrefix(cShape, aShape+"/") {
+					return a, b, true
+				}
+				break
+			}
+		}
+	}
+	return a, b, false
+}
+
+// paramShape returns the path with the names of its params removed and the
+// names of its params.
+func paramShape(path string) (shape, names string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, seg := range segments {
+		if isParam(seg) {
+			params = append(params, seg)
+			segments[i] = seg[:1]
+		}
+	}
+	return strings.Join(segments, "/"), strings.Join(params, "/")
+}
+
+// matchesPrefix reports whether the route path in the canonical syntax matches
+// a prefix of the request path, which may end within a segment.
+func matchesPrefix(route, path string) bool {
+	segments := strings.Split(route, "/")
+	requested := strings.Split(path, "/")
+	if len(segments) > len(requested) {
+		return false
+	}
+	for i, seg := range segments {
+		switch {
+		case isParam(seg) && seg[0] == '*':
+			return true
+		case isParam(seg):
+			if requested[i] == "" {
+				return false
+			}
+		case i == len(segments)-1:
+			return strings.HasPrefix(requested[i], seg)
+		case requested[i] != seg:
+			return false
+		}
+	}
+	return true
+}
+
+// conflicting returns the first two routes of the same method, which are
+// ambiguous at the same path segment: one of them has a param, which the other
+// one has not.
+func conflicting(routes []fixtures.Route) (a, b fixtures.Route, ok bool) {
+	type segment struct {
+		seg   string
+		route fixtures.Route
+	}
+	// segments at each path prefix, keyed by method and prefix with the names
+	// of params removed
+	segments := make(map[string][]segment)
+
+	for _, route := range routes {
+		prefix := route.Method
+		for _, seg := range strings.Split(route.Path, "/")[1:] {
+			known := false
+			for _, s := range segments[prefix] {
+				if s.seg == seg {
+					known = true
+				} else if isParam(s.seg) || isParam(seg) {
+					return s.route, route, true
+				}
+			}
+			if !known {
+				segments[prefix] = append(segments[prefix], segment{seg, route})
+			}
+
+			if isParam(seg) {
+				seg = seg[:1]
+			}
+			prefix += "/" + seg
+		}
+	}
+	return a, b, false
+}
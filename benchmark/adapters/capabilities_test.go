@@ -0,0 +1,118 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+func TestCapabilitiesCheck(t *testing.T) {
+	routes := func(paths ...string) []fixtures.Route {
+		routes := make([]fixtures.Route, len(paths))
+		for i, path := range paths {
+			routes[i] = fixtures.Route{Method: "GET", Path: path}
+		}
+		return routes
+	}
+	strict := Capabilities{MaxParams: 2}
+	all := Capabilities{CatchAll: true, ConflictingRoutes: true}
+
+	tests := []struct {
+		name   string
+		routes []fixtures.Route
+		strict bool // supported by strict
+	}{
+		{"static", routes("/", "/user", "/user/repos"), true},
+		{"params", routes("/user/:name", "/user/:name/repos", "/repos/:owner/:repo"), true},
+		{"too many params", routes("/:a/:b/:c"), false},
+		{"catch-all", routes("/src/*filepath"), false},
+		{"static and param", routes("/user/new", "/user/:name"), false},
+		{"different param names", routes("/user/:name", "/user/:id/repos"), false},
+		{"empty and catch-all", routes("/src/", "/src/*filepath"), false},
+		{"same path prefix", routes("/user/:name/repos", "/users/:name"), true},
+		{"different methods", []fixtures.Route{
+			{Method: "GET", Path: "/user/new"},
+			{Method: "POST", Path: "/user/:name"},
+		}, true},
+	}
+	for _, test := range tests {
+		if err := strict.Check(test.routes); (err == nil) != test.strict {
+			t.Errorf("%s: strict.Check() = %v, want supported %v", test.name, err, test.strict)
+		}
+		if err := all.Check(test.routes); err != nil {
+			t.Errorf("%s: all.Check() = %v, want nil", test.name, err)
+		}
+	}
+
+	identifiers := Capabilities{CatchAll: true, IdentifierParams: true}
+	if err := identifiers.Check(routes("/user/:name_1", "/src/*_")); err != nil {
+		t.Errorf("identifiers.Check() = %v, want nil", err)
+	}
+	for _, path := range []string{"/user/:0", "/user/:1st", "/src/*0"} {
+		if err := identifiers.Check(routes(path)); err == nil {
+			t.Errorf("identifiers.Check(%s) = nil, want error", path)
+		}
+	}
+
+	clean := Capabilities{CleanPaths: true}
+	for _, path := range []string{"/.", "/a/../b", "/a/./"} {
+		if err := clean.Check(routes(path)); err == nil {
+			t.Errorf("clean.Check(%s) = nil, want error", path)
+		}
+	}
+	if err := clean.Check(routes("/a.b/.c/..d")); err != nil {
+		t.Errorf("clean.Check() = %v, want nil", err)
+	}
+
+	prefix := Capabilities{CatchAll: true, ConflictingRoutes: true, PrefixRoutes: true}
+	for _, paths := range [][]string{
+		{"/user/:name/repos", "/user/:name", "/users", "/user", "/src/*filepath", "/"},
+		{"/user/new", "/user/:name"},
+	} {
+		if err := prefix.Check(routes(paths...)); err != nil {
+			t.Errorf("prefix.Check(%v) = %v, want nil", paths, err)
+		}
+	}
+	for _, paths := range [][]string{
+		{"/user/:name", "/user/:name/repos"},
+		{"/user", "/users"},
+		{"/src/*filepath", "/src/a"},
+		{"/user/:name", "/user/new"},
+		{"/", "/user"},
+	} {
+		if err := prefix.Check(routes(paths...)); err == nil {
+			t.Errorf("prefix.Check(%v) = nil, want error", paths)
+		}
+	}
+
+	shared := Capabilities{CatchAll: true, ConflictingRoutes: true, SharedParamNames: true}
+	for _, paths := range [][]string{
+		{"/user/:name", "/user/:name/repos/:repo"},
+		{"/user/:name/repos", "/user/:name"},
+		{"/user/:name", "/user/:id/repos", "/user/:id"},
+	} {
+		if err := shared.Check(routes(paths...)); err != nil {
+			t.Errorf("shared.Check(%v) = %v, want nil", paths, err)
+		}
+	}
+	for _, paths := range [][]string{
+		{"/user/:name/repos", "/user/:id"},
+		{"/user/:name/repos/:repo", "/user/:name"},
+		{"/src/:dir/*filepath", "/src/:dir"},
+		{"/user/:name/:repo/x", "/user/:name/:repo", "/user/:name"},
+	} {
+		if err := shared.Check(routes(paths...)); err == nil {
+			t.Errorf("shared.Check(%v) = nil, want error", paths)
+		}
+	}
+
+	for _, fixture := range [][]fixtures.Route{fixtures.GithubAPI, fixtures.ParseAPI, fixtures.StaticRoutes} {
+		if err := (Capabilities{}).Check(fixture); err != nil {
+			t.Errorf("fixture: %v", err)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "Chi",
+		Module: "github.com/go-chi/chi/v5",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.BraceStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadChi,
+		LoadSingle: loadChiSingle,
+		Configure:  configureChi,
+		TreeNode:   "chi.node",
+	})
+}
+
+func chiHandleWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, chi.URLParam(r, "name"))
+}
+
+func chiHandleContextWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, chi.RouteContext(r.Context()).URLParam("name"))
+}
+
+func chiHandleParams(w http.ResponseWriter, r *http.Request) {
+	ctx := chi.RouteContext(r.Context())
+	params := make(map[string]string, len(ctx.URLParams.Keys))
+	for i, key := range ctx.URLParams.Keys {
+		params[key] = ctx.URLParams.Values[i]
+	}
+	WriteParams(w, params)
+}
+
+func chiHandlerFor(kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite:
+		return chiHandleWrite
+	case HandlerContextWrite:
+		return chiHandleContextWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return chiHandleParams
+	}
+	return httpHandlerFunc
+}
+
+// chiHandle registers a handler for a route.
+func chiHandle(mux *chi.Mux, method, path string, h http.HandlerFunc) {
+	switch method {
+	case "GET":
+		mux.Get(path, h)
+	case "POST":
+		mux.Post(path, h)
+	case "PUT":
+		mux.Put(path, h)
+	case "PATCH":
+		mux.Patch(path, h)
+	case "DELETE":
+		mux.Delete(path, h)
+	default:
+		panic("Unknown HTTP method: " + method)
+	}
+}
+
+// chiHandleKind registers the handler of the kind for a route.
+func chiHandleKind(mux *chi.Mux, method, path string, kind HandlerKind) {
+	if kind == HandlerMethod {
+		chiHandle(mux, method, path, MethodHandler(method))
+		return
+	}
+	chiHandle(mux, method, path, chiHandlerFor(kind))
+}
+
+func loadChiMux(mux *chi.Mux, routes []fixtures.Route, kind HandlerKind) http.Handler {
+	for _, route := range routes {
+		chiHandleKind(mux, route.Method, route.Path, kind)
+	}
+	return mux
+}
+
+func loadChi(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	return loadChiMux(chi.NewRouter(), routes, kind)
+}
+
+func loadChiSingle(method, path string, kind HandlerKind) http.Handler {
+	mux := chi.NewRouter()
+	chiHandleKind(mux, method, path, kind)
+	return mux
+}
+
+// configureChi supports the options CleanPath, StripSlashes and
+// RedirectSlashes, which add the respective middleware to the mux.
+func configureChi(opts Options) (Router, error) {
+	if err := opts.Check("CleanPath", "StripSlashes", "RedirectSlashes"); err != nil {
+		return Router{}, err
+	}
+	cleanPath, err := opts.Bool("CleanPath", false)
+	if err != nil {
+		return Router{}, err
+	}
+	stripSlashes, err := opts.Bool("StripSlashes", false)
+	if err != nil {
+		return Router{}, err
+	}
+	redirectSlashes, err := opts.Bool("RedirectSlashes", false)
+	if err != nil {
+		return Router{}, err
+	}
+
+	// middlewares must be added before the routes
+	newMux := func() *chi.Mux {
+		mux := chi.NewRouter()
+		if cleanPath {
+			mux.Use(middleware.CleanPath)
+		}
+		if stripSlashes {
+			mux.Use(middleware.StripSlashes)
+		}
+		if redirectSlashes {
+			mux.Use(middleware.RedirectSlashes)
+		}
+		return mux
+	}
+	return Router{
+		Load: func(routes []fixtures.Route, kind HandlerKind) http.Handler {
+			return loadChiMux(newMux(), routes, kind)
+		},
+		LoadSingle: func(method, path string, kind HandlerKind) http.Handler {
+			mux := newMux()
+			chiHandleKind(mux, method, path, kind)
+			return mux
+		},
+	}, nil
+}
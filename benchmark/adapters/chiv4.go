@@ -0,0 +1,109 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	chiv4 "github.com/go-chi/chi"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	// ChiV4 is the last major version of chi before the v5 module, which is
+	// benchmarked as Chi, to compare the major versions side by side.
+	Register(Router{
+		Name:   "ChiV4",
+		Module: "github.com/go-chi/chi",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.BraceStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadChiV4,
+		LoadSingle: loadChiV4Single,
+		TreeNode:   "chi.node",
+	})
+}
+
+func chiV4HandleWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, chiv4.URLParam(r, "name"))
+}
+
+func chiV4HandleContextWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, chiv4.RouteContext(r.Context()).URLParam("name"))
+}
+
+func chiV4HandleParams(w http.ResponseWriter, r *http.Request) {
+	ctx := chiv4.RouteContext(r.Context())
+	params := make(map[string]string, len(ctx.URLParams.Keys))
+	for i, key := range ctx.URLParams.Keys {
+		params[key] = ctx.URLParams.Values[i]
+	}
+	WriteParams(w, params)
+}
+
+func chiV4HandlerFor(kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite:
+		return chiV4HandleWrite
+	case HandlerContextWrite:
+		return chiV4HandleContextWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return chiV4HandleParams
+	}
+	return httpHandlerFunc
+}
+
+// chiV4Handle registers a handler for a route.
+func chiV4Handle(mux *chiv4.Mux, method, path string, h http.HandlerFunc) {
+	switch method {
+	case "GET":
+		mux.Get(path, h)
+	case "POST":
+		mux.Post(path, h)
+	case "PUT":
+		mux.Put(path, h)
+	case "PATCH":
+		mux.Patch(path, h)
+	case "DELETE":
+		mux.Delete(path, h)
+	default:
+		panic("Unknown HTTP method: " + method)
+	}
+}
+
+func loadChiV4Mux(mux *chiv4.Mux, routes []fixtures.Route, kind HandlerKind) http.Handler {
+	h := chiV4HandlerFor(kind)
+
+	for _, route := range routes {
+		if kind == HandlerMethod {
+			chiV4Handle(mux, route.Method, route.Path, MethodHandler(route.Method))
+			continue
+		}
+		chiV4Handle(mux, route.Method, route.Path, h)
+	}
+	return mux
+}
+
+func loadChiV4(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	return loadChiV4Mux(chiv4.NewRouter(), routes, kind)
+}
+
+func loadChiV4Single(method, path string, kind HandlerKind) http.Handler {
+	mux := chiv4.NewRouter()
+	if kind == HandlerMethod {
+		chiV4Handle(mux, method, path, MethodHandler(method))
+		return mux
+	}
+	chiV4Handle(mux, method, path, chiV4HandlerFor(kind))
+	return mux
+}
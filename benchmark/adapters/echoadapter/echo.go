@@ -0,0 +1,121 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package echoadapter registers Echo in the adapters registry when imported.
+// It is a module of its own, so that Echo and its dependencies are only
+// downloaded if it is benchmarked.
+package echoadapter
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "Echo",
+		Module: "github.com/labstack/echo/v4",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Colon,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadEcho,
+		LoadSingle: loadEchoSingle,
+		TreeNode:   "echo.node",
+	})
+}
+
+func echoHandler(c echo.Context) error {
+	return nil
+}
+
+func echoHandlerWrite(c echo.Context) error {
+	io.WriteString(c.Response(), c.Param("name"))
+	return nil
+}
+
+func echoHandlerTest(c echo.Context) error {
+	io.WriteString(c.Response(), c.Request().RequestURI)
+	return nil
+}
+
+func echoHandlerParams(c echo.Context) error {
+	names, values := c.ParamNames(), c.ParamValues()
+	params := make(map[string]string, len(names))
+	for i, name := range names {
+		params[name] = values[i]
+	}
+	adapters.WriteParams(c.Response(), params)
+	return nil
+}
+
+func echoHandlerFor(kind adapters.HandlerKind) echo.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return echoHandlerWrite
+	case adapters.HandlerTest:
+		return echoHandlerTest
+	case adapters.HandlerParams:
+		return echoHandlerParams
+	}
+	return echoHandler
+}
+
+func loadEcho(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := echoHandlerFor(kind)
+
+	e := echo.New()
+	for _, r := range routes {
+		h := h
+		if kind == adapters.HandlerMethod {
+			h = echo.WrapHandler(adapters.MethodHandler(r.Method))
+		}
+		switch r.Method {
+		case "GET":
+			e.GET(r.Path, h)
+		case "POST":
+			e.POST(r.Path, h)
+		case "PUT":
+			e.PUT(r.Path, h)
+		case "PATCH":
+			e.PATCH(r.Path, h)
+		case "DELETE":
+			e.DELETE(r.Path, h)
+		default:
+			panic("Unknow HTTP method: " + r.Method)
+		}
+	}
+	return e
+}
+
+func loadEchoSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	h := echoHandlerFor(kind)
+	if kind == adapters.HandlerMethod {
+		h = echo.WrapHandler(adapters.MethodHandler(method))
+	}
+
+	e := echo.New()
+	switch method {
+	case "GET":
+		e.GET(path, h)
+	case "POST":
+		e.POST(path, h)
+	case "PUT":
+		e.PUT(path, h)
+	case "PATCH":
+		e.PATCH(path, h)
+	case "DELETE":
+		e.DELETE(path, h)
+	default:
+		panic("Unknow HTTP method: " + method)
+	}
+	return e
+}
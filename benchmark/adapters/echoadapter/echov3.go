@@ -0,0 +1,121 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package echoadapter
+
+import (
+	"io"
+	"net/http"
+
+	echov3 "github.com/labstack/echo"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "EchoV3",
+		Module: "github.com/labstack/echo",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Colon,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadEchoV3,
+		LoadSingle: loadEchoV3Single,
+		TreeNode:   "echo.node",
+	})
+}
+
+// EchoV3 is the last major version of Echo before the v4 module, which is
+// benchmarked as Echo, to compare the major versions side by side.
+
+func echoV3Handler(c echov3.Context) error {
+	return nil
+}
+
+func echoV3HandlerWrite(c echov3.Context) error {
+	io.WriteString(c.Response(), c.Param("name"))
+	return nil
+}
+
+func echoV3HandlerTest(c echov3.Context) error {
+	io.WriteString(c.Response(), c.Request().RequestURI)
+	return nil
+}
+
+func echoV3HandlerParams(c echov3.Context) error {
+	names, values := c.ParamNames(), c.ParamValues()
+	params := make(map[string]string, len(names))
+	for i, name := range names {
+		params[name] = values[i]
+	}
+	adapters.WriteParams(c.Response(), params)
+	return nil
+}
+
+func echoV3HandlerFor(kind adapters.HandlerKind) echov3.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return echoV3HandlerWrite
+	case adapters.HandlerTest:
+		return echoV3HandlerTest
+	case adapters.HandlerParams:
+		return echoV3HandlerParams
+	}
+	return echoV3Handler
+}
+
+func loadEchoV3(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := echoV3HandlerFor(kind)
+
+	e := echov3.New()
+	for _, r := range routes {
+		h := h
+		if kind == adapters.HandlerMethod {
+			h = echov3.WrapHandler(adapters.MethodHandler(r.Method))
+		}
+		switch r.Method {
+		case "GET":
+			e.GET(r.Path, h)
+		case "POST":
+			e.POST(r.Path, h)
+		case "PUT":
+			e.PUT(r.Path, h)
+		case "PATCH":
+			e.PATCH(r.Path, h)
+		case "DELETE":
+			e.DELETE(r.Path, h)
+		default:
+			panic("Unknow HTTP method: " + r.Method)
+		}
+	}
+	return e
+}
+
+func loadEchoV3Single(method, path string, kind adapters.HandlerKind) http.Handler {
+	h := echoV3HandlerFor(kind)
+	if kind == adapters.HandlerMethod {
+		h = echov3.WrapHandler(adapters.MethodHandler(method))
+	}
+
+	e := echov3.New()
+	switch method {
+	case "GET":
+		e.GET(path, h)
+	case "POST":
+		e.POST(path, h)
+	case "PUT":
+		e.PUT(path, h)
+	case "PATCH":
+		e.PATCH(path, h)
+	case "DELETE":
+		e.DELETE(path, h)
+	default:
+		panic("Unknow HTTP method: " + method)
+	}
+	return e
+}
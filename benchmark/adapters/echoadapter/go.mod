@@ -0,0 +1,12 @@
+module github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/echoadapter
+
+go 1.13
+
+require (
+	github.com/julienschmidt/go-http-routing-benchmark v0.0.0-00010101000000-000000000000
+	github.com/labstack/echo v3.3.10+incompatible
+	github.com/labstack/echo/v4 v4.1.11
+	golang.org/x/net v0.0.0-20190926025831-c00fd9afed17 // indirect
+)
+
+replace github.com/julienschmidt/go-http-routing-benchmark => ../../..
@@ -0,0 +1,104 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package fasthttpbridge serves the requests of net/http with the request
+// handlers of fasthttp, so that routers based on fasthttp can be benchmarked
+// like the others. It is a module of its own, so that fasthttp is only
+// downloaded if such a router is benchmarked.
+//
+// The fasthttpadaptor package of fasthttp converts in the opposite direction,
+// serving fasthttp requests with an http.Handler.
+package fasthttpbridge
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/valyala/fasthttp"
+)
+
+// Handler returns an http.Handler serving the requests with h, the request
+// handler of router. The router is kept for the statistics of its tree, see
+// adapters.Router.Tree. The contexts of the requests are pooled like by the
+// server of fasthttp, while copying the requests and responses is part of the
+// results of the routers.
+func Handler(router interface{}, h fasthttp.RequestHandler) http.Handler {
+	return &handler{router, h}
+}
+
+type handler struct {
+	router interface{}
+	serve  fasthttp.RequestHandler
+}
+
+var ctxPool = sync.Pool{
+	New: func() interface{} {
+		ctx := new(fasthttp.RequestCtx)
+		ctx.Init(new(fasthttp.Request), nil, nil)
+		return ctx
+	},
+}
+
+// defaultContentType is the content type fasthttp sets, if the handler did not.
+var defaultContentType = string(new(fasthttp.Response).Header.ContentType())
+
+// RequestURI returns the request target of r in the origin form, which is all
+// the routers expect. Request targets in another form, e.g. A: in the
+// authority form, are converted.
+func RequestURI(r *http.Request) string {
+	uri := r.RequestURI
+	if !strings.HasPrefix(uri, "/") {
+		uri = r.URL.EscapedPath()
+		if r.URL.RawQuery != "" {
+			uri += "?" + r.URL.RawQuery
+		}
+	}
+	return uri
+}
+
+// CopyHeader adds the response header name with value to w, unless net/http
+// sets it itself: the content length and the default content type, which
+// fasthttp and routers modeled after it set if the handler did not.
+func CopyHeader(w http.ResponseWriter, name, value []byte) {
+	switch {
+	case string(name) == fasthttp.HeaderContentLength:
+	case string(name) == fasthttp.HeaderContentType && string(value) == defaultContentType:
+	default:
+		w.Header().Add(string(name), string(value))
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := ctxPool.Get().(*fasthttp.RequestCtx)
+	defer ctxPool.Put(ctx)
+	ctx.Request.Reset()
+	ctx.Response.Reset()
+	ctx.ResetUserValues()
+
+	uri := RequestURI(r)
+	ctx.Request.Header.SetMethod(r.Method)
+	ctx.Request.SetRequestURI(uri)
+	ctx.Request.Header.SetHost(r.Host)
+	for name, values := range r.Header {
+		for _, value := range values {
+			ctx.Request.Header.Add(name, value)
+		}
+	}
+	if r.Body != nil && r.Body != http.NoBody {
+		ctx.Request.SetBodyStream(r.Body, int(r.ContentLength))
+	}
+
+	h.serve(ctx)
+
+	ctx.Response.Header.VisitAll(func(name, value []byte) {
+		CopyHeader(w, name, value)
+	})
+	if code := ctx.Response.StatusCode(); code != http.StatusOK {
+		w.WriteHeader(code)
+	}
+	if body := ctx.Response.Body(); len(body) > 0 {
+		w.Write(body)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package fasthttprouteradapter registers fasthttp/router in the adapters
+// registry when imported. It is a module of its own, so that fasthttp/router
+// and its dependencies are only downloaded if it is benchmarked.
+package fasthttprouteradapter
+
+import (
+	"net/http"
+
+	"github.com/fasthttp/router"
+	"github.com/valyala/fasthttp"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/fasthttpbridge"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "FastHttpRouter",
+		Module: "github.com/fasthttp/router",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.BraceWildcard,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadFastHTTPRouter,
+		LoadSingle: loadFastHTTPRouterSingle,
+		TreeNode:   "radix.node",
+	})
+}
+
+func fastHTTPRouterHandle(_ *fasthttp.RequestCtx) {}
+
+func fastHTTPRouterHandleWrite(ctx *fasthttp.RequestCtx) {
+	name, _ := ctx.UserValue("name").(string)
+	ctx.WriteString(name)
+}
+
+func fastHTTPRouterHandleTest(ctx *fasthttp.RequestCtx) {
+	ctx.Write(ctx.RequestURI())
+}
+
+func fastHTTPRouterHandleParams(ctx *fasthttp.RequestCtx) {
+	params := make(map[string]string)
+	ctx.VisitUserValues(func(key []byte, value interface{}) {
+		params[string(key)], _ = value.(string)
+	})
+	adapters.WriteParams(ctx, params)
+}
+
+func fastHTTPRouterHandleMethod(method string) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		ctx.WriteString(method)
+	}
+}
+
+func fastHTTPRouterHandleFor(kind adapters.HandlerKind) fasthttp.RequestHandler {
+	switch kind {
+	case adapters.HandlerWrite:
+		return fastHTTPRouterHandleWrite
+	case adapters.HandlerTest:
+		return fastHTTPRouterHandleTest
+	case adapters.HandlerParams:
+		return fastHTTPRouterHandleParams
+	}
+	return fastHTTPRouterHandle
+}
+
+func loadFastHTTPRouter(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := fastHTTPRouterHandleFor(kind)
+
+	r := router.New()
+	for _, route := range routes {
+		path := adapters.EscapePath(route.Path)
+		if kind == adapters.HandlerMethod {
+			r.Handle(route.Method, path, fastHTTPRouterHandleMethod(route.Method))
+			continue
+		}
+		r.Handle(route.Method, path, h)
+	}
+	return fasthttpbridge.Handler(r, r.Handler)
+}
+
+func loadFastHTTPRouterSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	r := router.New()
+	path = adapters.EscapePath(path)
+	if kind == adapters.HandlerMethod {
+		r.Handle(method, path, fastHTTPRouterHandleMethod(method))
+		return fasthttpbridge.Handler(r, r.Handler)
+	}
+	r.Handle(method, path, fastHTTPRouterHandleFor(kind))
+	return fasthttpbridge.Handler(r, r.Handler)
+}
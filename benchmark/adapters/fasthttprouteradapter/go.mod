@@ -0,0 +1,45 @@
+module github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/fasthttprouteradapter
+
+go 1.21
+
+require (
+	github.com/fasthttp/router v1.5.4
+	github.com/julienschmidt/go-http-routing-benchmark v0.0.0-00010101000000-000000000000
+	github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/fasthttpbridge v0.0.0-00010101000000-000000000000
+	github.com/valyala/fasthttp v1.58.0
+)
+
+require (
+	github.com/andybalholm/brotli v1.1.1 // indirect
+	github.com/ant0ine/go-json-rest v3.3.2+incompatible // indirect
+	github.com/bmizerany/pat v0.0.0-20210406213842-e4b6760bdd6f // indirect
+	github.com/codegangsta/inject v0.0.0-20150114235600-33e0aa1cb7c0 // indirect
+	github.com/dimfeld/httptreemux/v5 v5.5.0 // indirect
+	github.com/emicklei/go-restful/v3 v3.13.0 // indirect
+	github.com/go-chi/chi v4.0.2+incompatible // indirect
+	github.com/go-chi/chi/v5 v5.2.0 // indirect
+	github.com/go-martini/martini v0.0.0-20170121215854-22fa46961aab // indirect
+	github.com/go-ozzo/ozzo-routing v2.1.4+incompatible // indirect
+	github.com/go-playground/form v3.1.4+incompatible // indirect
+	github.com/go-playground/pkg v0.0.0-20190513234448-af45a46936e9 // indirect
+	github.com/go-playground/pure v0.0.0-20190513234712-ab95fef1be7a // indirect
+	github.com/gocraft/web v0.0.0-20190207150652-9707327fb69b // indirect
+	github.com/gorilla/mux v1.7.3 // indirect
+	github.com/husobee/vestigo v1.1.1 // indirect
+	github.com/julienschmidt/httprouter v1.3.0 // indirect
+	github.com/kataras/muxie v1.1.2 // indirect
+	github.com/klauspost/compress v1.17.11 // indirect
+	github.com/matryer/way v0.0.0-20180416093233-9632d0c407b0 // indirect
+	github.com/savsgio/gotils v0.0.0-20240704082632-aef3928b8a38 // indirect
+	github.com/urfave/negroni v1.0.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	github.com/xujiajun/gorouter v1.2.0 // indirect
+	github.com/zenazn/goji v1.0.1 // indirect
+	goji.io/v3 v3.0.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+)
+
+replace (
+	github.com/julienschmidt/go-http-routing-benchmark => ../../..
+	github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/fasthttpbridge => ../fasthttpbridge
+)
@@ -0,0 +1,98 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package fasthttproutingadapter registers qiangxue/fasthttp-routing in the
+// adapters registry when imported. It is a module of its own, so that fasthttp
+// is only downloaded if it is benchmarked.
+package fasthttproutingadapter
+
+import (
+	"net/http"
+
+	routing "github.com/qiangxue/fasthttp-routing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/fasthttpbridge"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "FastHttpRouting",
+		Module: "github.com/qiangxue/fasthttp-routing",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Angle,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadFastHTTPRouting,
+		LoadSingle: loadFastHTTPRoutingSingle,
+		TreeNode:   "routing.node",
+	})
+}
+
+func fastHTTPRoutingHandle(_ *routing.Context) error { return nil }
+
+func fastHTTPRoutingHandleWrite(c *routing.Context) error {
+	c.WriteString(c.Param("name"))
+	return nil
+}
+
+func fastHTTPRoutingHandleTest(c *routing.Context) error {
+	c.Write(c.RequestURI())
+	return nil
+}
+
+// fastHTTPRoutingHandleParams returns the HandlerParams handler of a route,
+// which knows the names of the params of the route, as fasthttp-routing only
+// provides the params by their names.
+func fastHTTPRoutingHandleParams(path string) routing.Handler {
+	names := adapters.ParamNames(path, pathsyntax.Angle)
+	return func(c *routing.Context) error {
+		params := make(map[string]string, len(names))
+		for _, name := range names {
+			params[name] = c.Param(name)
+		}
+		adapters.WriteParams(c, params)
+		return nil
+	}
+}
+
+func fastHTTPRoutingHandleMethod(method string) routing.Handler {
+	return func(c *routing.Context) error {
+		c.WriteString(method)
+		return nil
+	}
+}
+
+// fastHTTPRoutingHandleFor returns the handler of a route, as some handlers
+// depend on the route.
+func fastHTTPRoutingHandleFor(method, path string, kind adapters.HandlerKind) routing.Handler {
+	switch kind {
+	case adapters.HandlerWrite:
+		return fastHTTPRoutingHandleWrite
+	case adapters.HandlerTest:
+		return fastHTTPRoutingHandleTest
+	case adapters.HandlerParams:
+		return fastHTTPRoutingHandleParams(path)
+	case adapters.HandlerMethod:
+		return fastHTTPRoutingHandleMethod(method)
+	}
+	return fastHTTPRoutingHandle
+}
+
+func loadFastHTTPRouting(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	router := routing.New()
+	for _, route := range routes {
+		router.To(route.Method, route.Path, fastHTTPRoutingHandleFor(route.Method, route.Path, kind))
+	}
+	return fasthttpbridge.Handler(router, router.HandleRequest)
+}
+
+func loadFastHTTPRoutingSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	router := routing.New()
+	router.To(method, path, fastHTTPRoutingHandleFor(method, path, kind))
+	return fasthttpbridge.Handler(router, router.HandleRequest)
+}
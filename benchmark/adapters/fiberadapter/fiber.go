@@ -0,0 +1,95 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package fiberadapter registers Fiber in the adapters registry when imported.
+// It is a module of its own, so that Fiber and its dependencies are only
+// downloaded if it is benchmarked.
+package fiberadapter
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/fasthttpbridge"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "Fiber",
+		Module: "github.com/gofiber/fiber/v2",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.ColonStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			MaxParams:         30,
+		},
+		Load:       loadFiber,
+		LoadSingle: loadFiberSingle,
+	})
+}
+
+func fiberHandle(_ *fiber.Ctx) error {
+	return nil
+}
+
+func fiberHandleWrite(c *fiber.Ctx) error {
+	return c.SendString(c.Params("name"))
+}
+
+func fiberHandleTest(c *fiber.Ctx) error {
+	return c.SendString(c.OriginalURL())
+}
+
+func fiberHandleParams(c *fiber.Ctx) error {
+	adapters.WriteParams(c, c.AllParams())
+	return nil
+}
+
+func fiberHandleMethod(method string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.SendString(method)
+	}
+}
+
+func fiberHandleFor(kind adapters.HandlerKind) fiber.Handler {
+	switch kind {
+	case adapters.HandlerWrite:
+		return fiberHandleWrite
+	case adapters.HandlerTest:
+		return fiberHandleTest
+	case adapters.HandlerParams:
+		return fiberHandleParams
+	}
+	return fiberHandle
+}
+
+func loadFiber(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := fiberHandleFor(kind)
+
+	app := fiber.New()
+	for _, route := range routes {
+		path := adapters.EscapePath(route.Path)
+		if kind == adapters.HandlerMethod {
+			app.Add(route.Method, path, fiberHandleMethod(route.Method))
+			continue
+		}
+		app.Add(route.Method, path, h)
+	}
+	return fasthttpbridge.Handler(app, app.Handler())
+}
+
+func loadFiberSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	app := fiber.New()
+	path = adapters.EscapePath(path)
+	if kind == adapters.HandlerMethod {
+		app.Add(method, path, fiberHandleMethod(method))
+		return fasthttpbridge.Handler(app, app.Handler())
+	}
+	app.Add(method, path, fiberHandleFor(kind))
+	return fasthttpbridge.Handler(app, app.Handler())
+}
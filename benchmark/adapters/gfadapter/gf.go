@@ -0,0 +1,141 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package gfadapter registers the router of the GoFrame HTTP server in the
+// adapters registry when imported. It is a module of its own, so that GoFrame
+// and its dependencies are only downloaded if it is benchmarked.
+package gfadapter
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/gogf/gf/v2/net/ghttp"
+	"github.com/gogf/gf/v2/os/glog"
+	"github.com/gogf/gf/v2/os/gsession"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "GoFrame",
+		Module: "github.com/gogf/gf/v2",
+		Capabilities: adapters.Capabilities{
+			Dialect:           gfDialect,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadGf,
+		LoadSingle: loadGfSingle,
+	})
+}
+
+// gfDialect writes the params as the {name} fields of GoFrame and the
+// catch-all params as its fuzzy *name params, e.g. /src/*filepath, since a
+// field only matches a single segment.
+var gfDialect = pathsyntax.Dialect{
+	Name: "gf",
+	Param: func(name string) string {
+		return "{" + name + "}"
+	},
+	CatchAll: pathsyntax.Colon.CatchAll,
+}
+
+func gfHandler(_ *ghttp.Request) {}
+
+func gfHandlerWrite(r *ghttp.Request) {
+	r.Response.WriteString(r.GetRouter("name").String())
+}
+
+func gfHandlerTest(r *ghttp.Request) {
+	r.Response.WriteString(r.RequestURI)
+}
+
+func gfHandlerParams(r *ghttp.Request) {
+	adapters.WriteParams(r.Response.BufferWriter, r.GetRouterMap())
+}
+
+func gfHandlerMethod(method string) ghttp.HandlerFunc {
+	return func(r *ghttp.Request) {
+		r.Response.WriteString(method)
+	}
+}
+
+func gfHandlerFor(method string, kind adapters.HandlerKind) ghttp.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return gfHandlerWrite
+	case adapters.HandlerTest:
+		return gfHandlerTest
+	case adapters.HandlerParams:
+		return gfHandlerParams
+	case adapters.HandlerMethod:
+		return gfHandlerMethod(method)
+	}
+	return gfHandler
+}
+
+// gfServers counts the servers, as GoFrame keeps a single server per name.
+var gfServers int64
+
+// newGf returns a new server without logging and with sessions in memory. It
+// does not limit the size of the request bodies, as it would wrap the body of
+// the request passed to ServeHTTP every time.
+func newGf() *ghttp.Server {
+	log := glog.New()
+	log.SetStdoutPrint(false)
+
+	s := ghttp.GetServer("benchmark-" + strconv.FormatInt(atomic.AddInt64(&gfServers, 1), 10))
+	s.SetAddr("127.0.0.1:0")
+	s.SetLogger(log)
+	s.SetDumpRouterMap(false)
+	s.SetSessionStorage(gsession.NewStorageMemory())
+	s.SetClientMaxBodySize(0)
+	return s
+}
+
+// gfServer serves the requests without a body with http.NoBody, which a
+// server passes for them, as GoFrame closes the body of every request.
+type gfServer struct {
+	*ghttp.Server
+}
+
+func (s gfServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Body == nil {
+		r.Body = http.NoBody
+	}
+	s.Server.ServeHTTP(w, r)
+}
+
+// startGf starts the server with its routes and shuts it down at once, as it
+// only sets up the serving of the requests, e.g. the sessions, once it is
+// started. The server still serves the requests passed to ServeHTTP, for which
+// it caches the handlers found for a method and path.
+func startGf(s *ghttp.Server) http.Handler {
+	if err := s.Start(); err != nil {
+		panic(err)
+	}
+	if err := s.Shutdown(); err != nil {
+		panic(err)
+	}
+	return gfServer{s}
+}
+
+func loadGf(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	s := newGf()
+	for _, route := range routes {
+		s.BindHandler(route.Method+":"+route.Path, gfHandlerFor(route.Method, kind))
+	}
+	return startGf(s)
+}
+
+func loadGfSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	s := newGf()
+	s.BindHandler(method+":"+path, gfHandlerFor(method, kind))
+	return startGf(s)
+}
@@ -0,0 +1,135 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package ginadapter registers Gin in the adapters registry when imported.
+// It is a module of its own, so that Gin and its dependencies are only
+// downloaded if it is benchmarked.
+package ginadapter
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	initGin()
+
+	adapters.Register(adapters.Router{
+		Name:   "Gin",
+		Module: "github.com/gin-gonic/gin",
+		Capabilities: adapters.Capabilities{
+			Dialect:  pathsyntax.Colon,
+			CatchAll: true,
+		},
+		Load:       loadGin,
+		LoadSingle: loadGinSingle,
+		Configure:  configureGin,
+		TreeNode:   "gin.node",
+	})
+}
+
+func ginHandle(_ *gin.Context) {}
+
+func ginHandleWrite(c *gin.Context) {
+	io.WriteString(c.Writer, c.Params.ByName("name"))
+}
+
+func ginHandleTest(c *gin.Context) {
+	io.WriteString(c.Writer, c.Request.RequestURI)
+}
+
+func ginHandleParams(c *gin.Context) {
+	params := make(map[string]string, len(c.Params))
+	for _, p := range c.Params {
+		params[p.Key] = p.Value
+	}
+	adapters.WriteParams(c.Writer, params)
+}
+
+func ginHandleFor(kind adapters.HandlerKind) gin.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return ginHandleWrite
+	case adapters.HandlerTest:
+		return ginHandleTest
+	case adapters.HandlerParams:
+		return ginHandleParams
+	}
+	return ginHandle
+}
+
+func initGin() {
+	gin.SetMode(gin.ReleaseMode)
+}
+
+func loadGin(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := ginHandleFor(kind)
+
+	router := gin.New()
+	for _, route := range routes {
+		if kind == adapters.HandlerMethod {
+			router.Handle(route.Method, route.Path, gin.WrapF(adapters.MethodHandler(route.Method)))
+			continue
+		}
+		router.Handle(route.Method, route.Path, h)
+	}
+	return router
+}
+
+func loadGinSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	router := gin.New()
+	if kind == adapters.HandlerMethod {
+		router.Handle(method, path, gin.WrapF(adapters.MethodHandler(method)))
+		return router
+	}
+	router.Handle(method, path, ginHandleFor(kind))
+	return router
+}
+
+// configureGin supports the options UseRawPath, UnescapePathValues,
+// RedirectTrailingSlash and RedirectFixedPath of gin.Engine.
+func configureGin(opts adapters.Options) (adapters.Router, error) {
+	if err := opts.Check("UseRawPath", "UnescapePathValues", "RedirectTrailingSlash", "RedirectFixedPath"); err != nil {
+		return adapters.Router{}, err
+	}
+	useRawPath, err := opts.Bool("UseRawPath", false)
+	if err != nil {
+		return adapters.Router{}, err
+	}
+	unescapePathValues, err := opts.Bool("UnescapePathValues", true)
+	if err != nil {
+		return adapters.Router{}, err
+	}
+	redirectTrailingSlash, err := opts.Bool("RedirectTrailingSlash", true)
+	if err != nil {
+		return adapters.Router{}, err
+	}
+	redirectFixedPath, err := opts.Bool("RedirectFixedPath", false)
+	if err != nil {
+		return adapters.Router{}, err
+	}
+
+	configure := func(h http.Handler) http.Handler {
+		router := h.(*gin.Engine)
+		router.UseRawPath = useRawPath
+		router.UnescapePathValues = unescapePathValues
+		router.RedirectTrailingSlash = redirectTrailingSlash
+		router.RedirectFixedPath = redirectFixedPath
+		return router
+	}
+	return adapters.Router{
+		Load: func(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+			return configure(loadGin(routes, kind))
+		},
+		LoadSingle: func(method, path string, kind adapters.HandlerKind) http.Handler {
+			return configure(loadGinSingle(method, path, kind))
+		},
+	}, nil
+}
@@ -0,0 +1,10 @@
+module github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/ginadapter
+
+go 1.13
+
+require (
+	github.com/gin-gonic/gin v1.5.0
+	github.com/julienschmidt/go-http-routing-benchmark v0.0.0-00010101000000-000000000000
+)
+
+replace github.com/julienschmidt/go-http-routing-benchmark => ../../..
@@ -0,0 +1,111 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gocraft/web"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "GocraftWeb",
+		Module: "github.com/gocraft/web",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.ColonWildcard,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadGocraftWeb,
+		LoadSingle: loadGocraftWebSingle,
+		TreeNode:   "web.pathNode",
+	})
+}
+
+// gocraftWebContext is the context of the router. gocraft/web allocates it
+// per request and calls its methods as handlers through reflection.
+type gocraftWebContext struct{}
+
+func (c *gocraftWebContext) Handle(_ web.ResponseWriter, _ *web.Request) {}
+
+func (c *gocraftWebContext) HandleWrite(w web.ResponseWriter, r *web.Request) {
+	io.WriteString(w, r.PathParams["name"])
+}
+
+func (c *gocraftWebContext) HandleTest(w web.ResponseWriter, r *web.Request) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func (c *gocraftWebContext) HandleParams(w web.ResponseWriter, r *web.Request) {
+	WriteParams(w, r.PathParams)
+}
+
+// gocraftWebHandleMethod returns a handler without the context, which
+// gocraft/web supports as well.
+func gocraftWebHandleMethod(method string) func(web.ResponseWriter, *web.Request) {
+	h := MethodHandler(method)
+	return func(w web.ResponseWriter, r *web.Request) {
+		h(w, r.Request)
+	}
+}
+
+func gocraftWebHandleFor(kind HandlerKind) interface{} {
+	switch kind {
+	case HandlerWrite:
+		return (*gocraftWebContext).HandleWrite
+	case HandlerTest:
+		return (*gocraftWebContext).HandleTest
+	case HandlerParams:
+		return (*gocraftWebContext).HandleParams
+	}
+	return (*gocraftWebContext).Handle
+}
+
+// gocraftWebHandle registers a handler for a route.
+func gocraftWebHandle(router *web.Router, method, path string, h interface{}) {
+	switch method {
+	case "GET":
+		router.Get(path, h)
+	case "POST":
+		router.Post(path, h)
+	case "PUT":
+		router.Put(path, h)
+	case "PATCH":
+		router.Patch(path, h)
+	case "DELETE":
+		router.Delete(path, h)
+	default:
+		panic("Unknown HTTP method: " + method)
+	}
+}
+
+func loadGocraftWeb(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	h := gocraftWebHandleFor(kind)
+
+	router := web.New(gocraftWebContext{})
+	for _, route := range routes {
+		if kind == HandlerMethod {
+			gocraftWebHandle(router, route.Method, route.Path, gocraftWebHandleMethod(route.Method))
+			continue
+		}
+		gocraftWebHandle(router, route.Method, route.Path, h)
+	}
+	return router
+}
+
+func loadGocraftWebSingle(method, path string, kind HandlerKind) http.Handler {
+	router := web.New(gocraftWebContext{})
+	if kind == HandlerMethod {
+		gocraftWebHandle(router, method, path, gocraftWebHandleMethod(method))
+		return router
+	}
+	gocraftWebHandle(router, method, path, gocraftWebHandleFor(kind))
+	return router
+}
@@ -0,0 +1,92 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/zenazn/goji/web"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "Goji",
+		Module: "github.com/zenazn/goji",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.ColonStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadGoji,
+		LoadSingle: loadGojiSingle,
+	})
+}
+
+// gojiFuncWrite gets the params passed in the web.C of Goji, unlike goji.io,
+// which stores them in the request context.
+func gojiFuncWrite(c web.C, w http.ResponseWriter, _ *http.Request) {
+	io.WriteString(w, c.URLParams["name"])
+}
+
+func gojiFuncParams(c web.C, w http.ResponseWriter, _ *http.Request) {
+	WriteParams(w, c.URLParams)
+}
+
+func gojiHandlerFor(kind HandlerKind) web.HandlerType {
+	switch kind {
+	case HandlerWrite:
+		return gojiFuncWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return gojiFuncParams
+	}
+	return httpHandlerFunc
+}
+
+func gojiHandle(m *web.Mux, method, path string, h web.HandlerType) {
+	switch method {
+	case "GET":
+		m.Get(path, h)
+	case "POST":
+		m.Post(path, h)
+	case "PUT":
+		m.Put(path, h)
+	case "PATCH":
+		m.Patch(path, h)
+	case "DELETE":
+		m.Delete(path, h)
+	default:
+		panic("Unknown HTTP method: " + method)
+	}
+}
+
+func loadGoji(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	h := gojiHandlerFor(kind)
+
+	m := web.New()
+	for _, route := range routes {
+		if kind == HandlerMethod {
+			gojiHandle(m, route.Method, route.Path, MethodHandler(route.Method))
+			continue
+		}
+		gojiHandle(m, route.Method, route.Path, h)
+	}
+	return m
+}
+
+func loadGojiSingle(method, path string, kind HandlerKind) http.Handler {
+	m := web.New()
+	if kind == HandlerMethod {
+		gojiHandle(m, method, path, MethodHandler(method))
+		return m
+	}
+	gojiHandle(m, method, path, gojiHandlerFor(kind))
+	return m
+}
@@ -0,0 +1,96 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	goji "goji.io/v3"
+	"goji.io/v3/pat"
+	"goji.io/v3/pattern"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "GojiIO",
+		Module: "goji.io/v3",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.ColonStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadGojiIO,
+		LoadSingle: loadGojiIOSingle,
+	})
+}
+
+// gojiIOHandlerWrite reads the params from the request context, since this is
+// the only way goji.io provides them. Unlike pat.Param, it does not panic for
+// routes without the name param.
+func gojiIOHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	name, _ := r.Context().Value(pattern.Variable("name")).(string)
+	io.WriteString(w, name)
+}
+
+// gojiIOHandlerParams writes the remaining path of catch-all routes as the
+// param *, as goji.io does not name it.
+func gojiIOHandlerParams(w http.ResponseWriter, r *http.Request) {
+	vars, _ := r.Context().Value(pattern.AllVariables).(map[pattern.Variable]interface{})
+	params := make(map[string]string, len(vars)+1)
+	for name, value := range vars {
+		params[string(name)], _ = value.(string)
+	}
+	if path := pattern.Path(r.Context()); path != "" {
+		params["*"] = path
+	}
+	WriteParams(w, params)
+}
+
+func gojiIOHandlerFor(kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite, HandlerContextWrite:
+		return gojiIOHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return gojiIOHandlerParams
+	}
+	return httpHandlerFunc
+}
+
+// gojiIOPattern returns the pattern of a route, whose path is escaped, as
+// goji.io matches the escaped paths of the requests.
+func gojiIOPattern(method, path string) *pat.Pattern {
+	return pat.NewWithMethods(EscapePath(path), method)
+}
+
+func loadGojiIO(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	h := gojiIOHandlerFor(kind)
+
+	mux := goji.NewMux()
+	for _, route := range routes {
+		if kind == HandlerMethod {
+			mux.HandleFunc(gojiIOPattern(route.Method, route.Path), MethodHandler(route.Method))
+			continue
+		}
+		mux.HandleFunc(gojiIOPattern(route.Method, route.Path), h)
+	}
+	return mux
+}
+
+func loadGojiIOSingle(method, path string, kind HandlerKind) http.Handler {
+	mux := goji.NewMux()
+	if kind == HandlerMethod {
+		mux.HandleFunc(gojiIOPattern(method, path), MethodHandler(method))
+		return mux
+	}
+	mux.HandleFunc(gojiIOPattern(method, path), gojiIOHandlerFor(kind))
+	return mux
+}
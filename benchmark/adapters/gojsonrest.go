@@ -0,0 +1,108 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/ant0ine/go-json-rest/rest"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "GoJsonRest",
+		Module: "github.com/ant0ine/go-json-rest",
+		Capabilities: Capabilities{
+			Dialect:  goJsonRestDialect,
+			CatchAll: true,
+		},
+		Load:       loadGoJsonRest,
+		LoadSingle: loadGoJsonRestSingle,
+		TreeNode:   "trie.node",
+	})
+}
+
+// goJsonRestDialect writes the params as relaxed placeholders like /user/#name,
+// as the :name placeholders of go-json-rest end at a dot as well, e.g. at
+// /:name.json.
+var goJsonRestDialect = pathsyntax.Dialect{
+	Name: "go-json-rest",
+	Param: func(name string) string {
+		return "#" + name
+	},
+	CatchAll: pathsyntax.Colon.CatchAll,
+}
+
+func goJsonRestHandler(_ rest.ResponseWriter, _ *rest.Request) {}
+
+// The rest.ResponseWriter only writes JSON, but is an io.Writer as well.
+func goJsonRestHandlerWrite(w rest.ResponseWriter, r *rest.Request) {
+	io.WriteString(w.(io.Writer), r.PathParam("name"))
+}
+
+func goJsonRestHandlerTest(w rest.ResponseWriter, r *rest.Request) {
+	io.WriteString(w.(io.Writer), r.RequestURI)
+}
+
+func goJsonRestHandlerParams(w rest.ResponseWriter, r *rest.Request) {
+	WriteParams(w.(io.Writer), r.PathParams)
+}
+
+func goJsonRestHandlerMethod(method string) rest.HandlerFunc {
+	return func(w rest.ResponseWriter, _ *rest.Request) {
+		io.WriteString(w.(io.Writer), method)
+	}
+}
+
+func goJsonRestHandlerFor(method string, kind HandlerKind) rest.HandlerFunc {
+	switch kind {
+	case HandlerWrite:
+		return goJsonRestHandlerWrite
+	case HandlerTest:
+		return goJsonRestHandlerTest
+	case HandlerParams:
+		return goJsonRestHandlerParams
+	case HandlerMethod:
+		return goJsonRestHandlerMethod(method)
+	}
+	return goJsonRestHandler
+}
+
+// goJsonRestHandle returns the handler of an API without middlewares, whose
+// router is made of the routes at once.
+func goJsonRestHandle(routes []*rest.Route) http.Handler {
+	router, err := rest.MakeRouter(routes...)
+	if err != nil {
+		panic(err)
+	}
+
+	api := rest.NewApi()
+	api.SetApp(router)
+	return api.MakeHandler()
+}
+
+func loadGoJsonRest(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	restRoutes := make([]*rest.Route, 0, len(routes))
+	for _, route := range routes {
+		restRoutes = append(restRoutes, &rest.Route{
+			HttpMethod: route.Method,
+			PathExp:    route.Path,
+			Func:       goJsonRestHandlerFor(route.Method, kind),
+		})
+	}
+	return goJsonRestHandle(restRoutes)
+}
+
+func loadGoJsonRestSingle(method, path string, kind HandlerKind) http.Handler {
+	return goJsonRestHandle([]*rest.Route{{
+		HttpMethod: method,
+		PathExp:    path,
+		Func:       goJsonRestHandlerFor(method, kind),
+	}})
+}
@@ -0,0 +1,129 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "GoRestful",
+		Module: "github.com/emicklei/go-restful/v3",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.BraceWildcard,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadGoRestful,
+		LoadSingle: loadGoRestfulSingle,
+		Configure:  configureGoRestful,
+	})
+}
+
+func goRestfulHandle(_ *restful.Request, _ *restful.Response) {}
+
+func goRestfulHandleWrite(r *restful.Request, w *restful.Response) {
+	io.WriteString(w, r.PathParameter("name"))
+}
+
+func goRestfulHandleTest(r *restful.Request, w *restful.Response) {
+	io.WriteString(w, r.Request.RequestURI)
+}
+
+func goRestfulHandleParams(r *restful.Request, w *restful.Response) {
+	WriteParams(w, r.PathParameters())
+}
+
+func goRestfulHandleMethod(method string) restful.RouteFunction {
+	h := MethodHandler(method)
+	return func(r *restful.Request, w *restful.Response) {
+		h(w, r.Request)
+	}
+}
+
+func goRestfulHandleFor(kind HandlerKind) restful.RouteFunction {
+	switch kind {
+	case HandlerWrite:
+		return goRestfulHandleWrite
+	case HandlerTest:
+		return goRestfulHandleTest
+	case HandlerParams:
+		return goRestfulHandleParams
+	}
+	return goRestfulHandle
+}
+
+// goRestfulRoute adds a route to the web service.
+func goRestfulRoute(ws *restful.WebService, method, path string, kind HandlerKind) {
+	h := goRestfulHandleFor(kind)
+	if kind == HandlerMethod {
+		h = goRestfulHandleMethod(method)
+	}
+	ws.Route(ws.Method(method).Path(path).To(h))
+}
+
+// newGoRestful returns a container with the web service of the routes, which
+// selects the routes with the given router.
+func newGoRestful(router restful.RouteSelector, ws *restful.WebService) http.Handler {
+	container := restful.NewContainer()
+	container.Router(router)
+	container.Add(ws)
+	return container
+}
+
+func loadGoRestfulWith(router restful.RouteSelector, routes []fixtures.Route, kind HandlerKind) http.Handler {
+	ws := new(restful.WebService)
+	for _, route := range routes {
+		goRestfulRoute(ws, route.Method, route.Path, kind)
+	}
+	return newGoRestful(router, ws)
+}
+
+func loadGoRestfulSingleWith(router restful.RouteSelector, method, path string, kind HandlerKind) http.Handler {
+	ws := new(restful.WebService)
+	goRestfulRoute(ws, method, path, kind)
+	return newGoRestful(router, ws)
+}
+
+func loadGoRestful(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	return loadGoRestfulWith(restful.CurlyRouter{}, routes, kind)
+}
+
+func loadGoRestfulSingle(method, path string, kind HandlerKind) http.Handler {
+	return loadGoRestfulSingleWith(restful.CurlyRouter{}, method, path, kind)
+}
+
+// configureGoRestful supports the option CurlyRouter, which is enabled by
+// default. If disabled, the routes are selected by the original
+// restful.RouterJSR311, which matches the regular expressions of all routes.
+func configureGoRestful(opts Options) (Router, error) {
+	if err := opts.Check("CurlyRouter"); err != nil {
+		return Router{}, err
+	}
+	curlyRouter, err := opts.Bool("CurlyRouter", true)
+	if err != nil {
+		return Router{}, err
+	}
+
+	var router restful.RouteSelector = restful.CurlyRouter{}
+	if !curlyRouter {
+		router = restful.RouterJSR311{}
+	}
+	return Router{
+		Load: func(routes []fixtures.Route, kind HandlerKind) http.Handler {
+			return loadGoRestfulWith(router, routes, kind)
+		},
+		LoadSingle: func(method, path string, kind HandlerKind) http.Handler {
+			return loadGoRestfulSingleWith(router, method, path, kind)
+		},
+	}, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "GorillaMux",
+		Module: "github.com/gorilla/mux",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.Brace,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadGorillaMux,
+		LoadSingle: loadGorillaMuxSingle,
+	})
+}
+
+// gorillaHandlerWrite reads the params from the request context, since this is
+// the only way mux provides them.
+func gorillaHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	io.WriteString(w, params["name"])
+}
+
+func gorillaHandlerParams(w http.ResponseWriter, r *http.Request) {
+	WriteParams(w, mux.Vars(r))
+}
+
+func gorillaHandlerFor(kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite, HandlerContextWrite:
+		return gorillaHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return gorillaHandlerParams
+	}
+	return httpHandlerFunc
+}
+
+func loadGorillaMux(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	h := gorillaHandlerFor(kind)
+
+	m := mux.NewRouter()
+	for _, route := range routes {
+		if kind == HandlerMethod {
+			m.HandleFunc(route.Path, MethodHandler(route.Method)).Methods(route.Method)
+			continue
+		}
+		m.HandleFunc(route.Path, h).Methods(route.Method)
+	}
+	return m
+}
+
+func loadGorillaMuxSingle(method, path string, kind HandlerKind) http.Handler {
+	m := mux.NewRouter()
+	if kind == HandlerMethod {
+		m.HandleFunc(path, MethodHandler(method)).Methods(method)
+		return m
+	}
+	m.HandleFunc(path, gorillaHandlerFor(kind)).Methods(method)
+	return m
+}
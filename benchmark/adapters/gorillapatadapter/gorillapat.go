@@ -0,0 +1,89 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package gorillapatadapter registers gorilla/pat in the adapters registry when
+// imported. It is a module of its own, so that gorilla/pat and the version of
+// gorilla/mux it requires are only downloaded if it is benchmarked.
+package gorillapatadapter
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/pat"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "GorillaPat",
+		Module: "github.com/gorilla/pat",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Brace,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			PrefixRoutes:      true,
+		},
+		Load:       loadGorillaPat,
+		LoadSingle: loadGorillaPatSingle,
+	})
+}
+
+// gorilla/pat matches the routes with gorilla/mux, but writes the params into
+// the query of the request prefixed with a colon, as pat does. Thus the
+// handlers read them from the query.
+
+func gorillaPatHandler(w http.ResponseWriter, r *http.Request) {}
+
+func gorillaPatHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.URL.Query().Get(":name"))
+}
+
+func gorillaPatHandlerTest(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func gorillaPatHandlerParams(w http.ResponseWriter, r *http.Request) {
+	params := make(map[string]string)
+	for name, values := range r.URL.Query() {
+		if strings.HasPrefix(name, ":") {
+			params[name[1:]] = values[0]
+		}
+	}
+	adapters.WriteParams(w, params)
+}
+
+func gorillaPatHandlerFor(method string, kind adapters.HandlerKind) http.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return gorillaPatHandlerWrite
+	case adapters.HandlerTest:
+		return gorillaPatHandlerTest
+	case adapters.HandlerParams:
+		return gorillaPatHandlerParams
+	case adapters.HandlerMethod:
+		return adapters.MethodHandler(method)
+	}
+	return gorillaPatHandler
+}
+
+// loadGorillaPat registers the routes in their order, as gorilla/pat serves the
+// first route matching a prefix of the path, see adapters.Capabilities.
+func loadGorillaPat(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	m := pat.New()
+	for _, route := range routes {
+		m.Add(route.Method, route.Path, gorillaPatHandlerFor(route.Method, kind))
+	}
+	return m
+}
+
+func loadGorillaPatSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	m := pat.New()
+	m.Add(method, path, gorillaPatHandlerFor(method, kind))
+	return m
+}
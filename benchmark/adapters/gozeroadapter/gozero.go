@@ -0,0 +1,96 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package gozeroadapter registers the router of go-zero in the adapters
+// registry when imported. It is a module of its own, so that go-zero and its
+// dependencies are only downloaded if it is benchmarked.
+package gozeroadapter
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+	"github.com/zeromicro/go-zero/rest/router"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "GoZero",
+		Module: "github.com/zeromicro/go-zero",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Colon,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadGoZero,
+		LoadSingle: loadGoZeroSingle,
+		TreeNode:   "search.node",
+	})
+}
+
+func goZeroHandle(_ http.ResponseWriter, _ *http.Request) {}
+
+// goZeroHandleWrite reads the params from the request context, since this is
+// the only way go-zero provides them.
+func goZeroHandleWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, pathvar.Vars(r)["name"])
+}
+
+func goZeroHandleTest(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func goZeroHandleParams(w http.ResponseWriter, r *http.Request) {
+	adapters.WriteParams(w, pathvar.Vars(r))
+}
+
+func goZeroHandleFor(kind adapters.HandlerKind) http.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite, adapters.HandlerContextWrite:
+		return goZeroHandleWrite
+	case adapters.HandlerTest:
+		return goZeroHandleTest
+	case adapters.HandlerParams:
+		return goZeroHandleParams
+	}
+	return goZeroHandle
+}
+
+// goZeroAdd registers a handler for a route with the router rest.Server
+// uses, without the middleware of the server.
+func goZeroAdd(r httpx.Router, method, path string, h http.Handler) {
+	if err := r.Handle(method, path, h); err != nil {
+		panic(err)
+	}
+}
+
+func loadGoZero(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := goZeroHandleFor(kind)
+
+	r := router.NewRouter()
+	for _, route := range routes {
+		if kind == adapters.HandlerMethod {
+			goZeroAdd(r, route.Method, route.Path, adapters.MethodHandler(route.Method))
+			continue
+		}
+		goZeroAdd(r, route.Method, route.Path, h)
+	}
+	return r
+}
+
+func loadGoZeroSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	r := router.NewRouter()
+	if kind == adapters.HandlerMethod {
+		goZeroAdd(r, method, path, adapters.MethodHandler(method))
+		return r
+	}
+	goZeroAdd(r, method, path, goZeroHandleFor(kind))
+	return r
+}
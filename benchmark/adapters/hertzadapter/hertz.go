@@ -0,0 +1,159 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package hertzadapter registers the route engine of Hertz in the adapters
+// registry when imported. It is a module of its own, so that Hertz and its
+// dependencies are only downloaded if it is benchmarked.
+package hertzadapter
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/config"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/route"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/fasthttpbridge"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	initHertz()
+
+	adapters.Register(adapters.Router{
+		Name:   "Hertz",
+		Module: "github.com/cloudwego/hertz",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Colon,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadHertz,
+		LoadSingle: loadHertzSingle,
+		TreeNode:   "route.node",
+	})
+}
+
+func hertzHandle(_ context.Context, _ *app.RequestContext) {}
+
+func hertzHandleWrite(_ context.Context, ctx *app.RequestContext) {
+	ctx.WriteString(ctx.Param("name"))
+}
+
+func hertzHandleTest(_ context.Context, ctx *app.RequestContext) {
+	ctx.Write(ctx.Request.RequestURI())
+}
+
+func hertzHandleParams(_ context.Context, ctx *app.RequestContext) {
+	params := make(map[string]string, len(ctx.Params))
+	for _, p := range ctx.Params {
+		params[p.Key] = p.Value
+	}
+	adapters.WriteParams(ctx, params)
+}
+
+func hertzHandleMethod(method string) app.HandlerFunc {
+	return func(_ context.Context, ctx *app.RequestContext) {
+		ctx.WriteString(method)
+	}
+}
+
+func hertzHandleFor(kind adapters.HandlerKind) app.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return hertzHandleWrite
+	case adapters.HandlerTest:
+		return hertzHandleTest
+	case adapters.HandlerParams:
+		return hertzHandleParams
+	}
+	return hertzHandle
+}
+
+// hertzEngine serves the requests of net/http with the route engine of Hertz,
+// without its server and network layer. The request contexts are pooled like
+// by the engine, while copying the requests and responses is part of the
+// results, as for the routers based on fasthttp.
+type hertzEngine struct {
+	engine *route.Engine
+	pool   sync.Pool
+}
+
+func newHertzEngine(engine *route.Engine) *hertzEngine {
+	e := &hertzEngine{engine: engine}
+	e.pool.New = func() interface{} {
+		return engine.NewContext()
+	}
+	return e
+}
+
+func (e *hertzEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := e.pool.Get().(*app.RequestContext)
+	defer e.pool.Put(ctx)
+	ctx.Reset()
+
+	uri := fasthttpbridge.RequestURI(r)
+	ctx.Request.SetMethod(r.Method)
+	ctx.Request.SetRequestURI(uri)
+	ctx.Request.SetHost(r.Host)
+	for name, values := range r.Header {
+		for _, value := range values {
+			ctx.Request.Header.Add(name, value)
+		}
+	}
+	if r.Body != nil && r.Body != http.NoBody {
+		ctx.Request.SetBodyStream(r.Body, int(r.ContentLength))
+	}
+
+	e.engine.ServeHTTP(context.Background(), ctx)
+
+	ctx.Response.Header.VisitAll(func(name, value []byte) {
+		fasthttpbridge.CopyHeader(w, name, value)
+	})
+	if code := ctx.Response.StatusCode(); code != http.StatusOK {
+		w.WriteHeader(code)
+	}
+	if body := ctx.Response.Body(); len(body) > 0 {
+		w.Write(body)
+	}
+}
+
+// initHertz silences the debug log of the engine, which logs every route
+// registered.
+func initHertz() {
+	hlog.SetLevel(hlog.LevelWarn)
+}
+
+func newHertz() *route.Engine {
+	return route.NewEngine(config.NewOptions(nil))
+}
+
+func loadHertz(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := hertzHandleFor(kind)
+
+	engine := newHertz()
+	for _, route := range routes {
+		if kind == adapters.HandlerMethod {
+			engine.Handle(route.Method, route.Path, hertzHandleMethod(route.Method))
+			continue
+		}
+		engine.Handle(route.Method, route.Path, h)
+	}
+	return newHertzEngine(engine)
+}
+
+func loadHertzSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	engine := newHertz()
+	if kind == adapters.HandlerMethod {
+		engine.Handle(method, path, hertzHandleMethod(method))
+		return newHertzEngine(engine)
+	}
+	engine.Handle(method, path, hertzHandleFor(kind))
+	return newHertzEngine(engine)
+}
@@ -0,0 +1,134 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "HttpRouter",
+		Module: "github.com/julienschmidt/httprouter",
+		Capabilities: Capabilities{
+			Dialect:       pathsyntax.Colon,
+			CatchAll:      true,
+			ContextParams: true,
+		},
+		Load:       loadHttpRouter,
+		LoadSingle: loadHttpRouterSingle,
+		Configure:  configureHttpRouter,
+		TreeNode:   "httprouter.node",
+	})
+}
+
+func httpRouterHandle(_ http.ResponseWriter, _ *http.Request, _ httprouter.Params) {}
+
+func httpRouterHandleWrite(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+	io.WriteString(w, ps.ByName("name"))
+}
+
+func httpRouterHandleTest(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func httpRouterHandleParams(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
+	params := make(map[string]string, len(ps))
+	for _, p := range ps {
+		params[p.Key] = p.Value
+	}
+	WriteParams(w, params)
+}
+
+// httpRouterHandlerContextWrite is registered as an http.Handler, for which
+// httprouter stores the params in the request context.
+func httpRouterHandlerContextWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, httprouter.ParamsFromContext(r.Context()).ByName("name"))
+}
+
+func httpRouterHandleFor(kind HandlerKind) httprouter.Handle {
+	switch kind {
+	case HandlerWrite:
+		return httpRouterHandleWrite
+	case HandlerTest:
+		return httpRouterHandleTest
+	case HandlerParams:
+		return httpRouterHandleParams
+	}
+	return httpRouterHandle
+}
+
+func loadHttpRouter(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	h := httpRouterHandleFor(kind)
+
+	router := httprouter.New()
+	for _, route := range routes {
+		switch kind {
+		case HandlerContextWrite:
+			router.HandlerFunc(route.Method, route.Path, httpRouterHandlerContextWrite)
+		case HandlerMethod:
+			router.HandlerFunc(route.Method, route.Path, MethodHandler(route.Method))
+		default:
+			router.Handle(route.Method, route.Path, h)
+		}
+	}
+	return router
+}
+
+func loadHttpRouterSingle(method, path string, kind HandlerKind) http.Handler {
+	router := httprouter.New()
+	switch kind {
+	case HandlerContextWrite:
+		router.HandlerFunc(method, path, httpRouterHandlerContextWrite)
+	case HandlerMethod:
+		router.HandlerFunc(method, path, MethodHandler(method))
+	default:
+		router.Handle(method, path, httpRouterHandleFor(kind))
+	}
+	return router
+}
+
+// configureHttpRouter supports the options RedirectTrailingSlash,
+// RedirectFixedPath and HandleMethodNotAllowed of httprouter.Router, which are
+// all enabled by default.
+func configureHttpRouter(opts Options) (Router, error) {
+	if err := opts.Check("RedirectTrailingSlash", "RedirectFixedPath", "HandleMethodNotAllowed"); err != nil {
+		return Router{}, err
+	}
+	redirectTrailingSlash, err := opts.Bool("RedirectTrailingSlash", true)
+	if err != nil {
+		return Router{}, err
+	}
+	redirectFixedPath, err := opts.Bool("RedirectFixedPath", true)
+	if err != nil {
+		return Router{}, err
+	}
+	handleMethodNotAllowed, err := opts.Bool("HandleMethodNotAllowed", true)
+	if err != nil {
+		return Router{}, err
+	}
+
+	configure := func(h http.Handler) http.Handler {
+		router := h.(*httprouter.Router)
+		router.RedirectTrailingSlash = redirectTrailingSlash
+		router.RedirectFixedPath = redirectFixedPath
+		router.HandleMethodNotAllowed = handleMethodNotAllowed
+		return router
+	}
+	return Router{
+		Load: func(routes []fixtures.Route, kind HandlerKind) http.Handler {
+			return configure(loadHttpRouter(routes, kind))
+		},
+		LoadSingle: func(method, path string, kind HandlerKind) http.Handler {
+			return configure(loadHttpRouterSingle(method, path, kind))
+		},
+	}, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/dimfeld/httptreemux/v5"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "HttpTreeMux",
+		Module: "github.com/dimfeld/httptreemux/v5",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.Colon,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadHttpTreeMux,
+		LoadSingle: loadHttpTreeMuxSingle,
+		TreeNode:   "httptreemux.node",
+	})
+}
+
+func httpTreeMuxHandle(_ http.ResponseWriter, _ *http.Request, _ map[string]string) {}
+
+func httpTreeMuxHandleWrite(w http.ResponseWriter, _ *http.Request, ps map[string]string) {
+	io.WriteString(w, ps["name"])
+}
+
+func httpTreeMuxHandleTest(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	io.WriteString(w, r.RequestURI)
+}
+
+func httpTreeMuxHandleParams(w http.ResponseWriter, _ *http.Request, ps map[string]string) {
+	WriteParams(w, ps)
+}
+
+// httpTreeMuxHandlerContextWrite is registered through a context group, for
+// which httptreemux stores the params in the request context.
+func httpTreeMuxHandlerContextWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, httptreemux.ContextParams(r.Context())["name"])
+}
+
+func httpTreeMuxHandleFor(kind HandlerKind) httptreemux.HandlerFunc {
+	switch kind {
+	case HandlerWrite:
+		return httpTreeMuxHandleWrite
+	case HandlerTest:
+		return httpTreeMuxHandleTest
+	case HandlerParams:
+		return httpTreeMuxHandleParams
+	}
+	return httpTreeMuxHandle
+}
+
+// httpTreeMuxGroups registers routes through the group API of httptreemux,
+// with a group per static first segment, e.g. /repos for
+// /repos/:owner/:repo, as an API would be registered.
+type httpTreeMuxGroups struct {
+	router *httptreemux.TreeMux
+	groups map[string]*httptreemux.Group
+}
+
+// newHttpTreeMuxGroups returns groups of a router which also adds the escaped
+// form of the routes, as it matches the escaped paths of the requests, e.g.
+// /caf%C3%A9 for /café.
+func newHttpTreeMuxGroups() *httpTreeMuxGroups {
+	router := httptreemux.New()
+	router.EscapeAddedRoutes = true
+	return &httpTreeMuxGroups{
+		router: router,
+		groups: make(map[string]*httptreemux.Group),
+	}
+}
+
+// group returns the group of the path and the path relative to it.
+func (g *httpTreeMuxGroups) group(path string) (*httptreemux.Group, string) {
+	prefix, rest := path, ""
+	if i := strings.IndexByte(path[1:], '/'); i >= 0 {
+		prefix, rest = path[:i+1], path[i+1:]
+	}
+	if len(prefix) < 2 || prefix[1] == ':' || prefix[1] == '*' {
+		return &g.router.Group, path
+	}
+
+	group, ok := g.groups[prefix]
+	if !ok {
+		group = g.router.NewGroup(prefix)
+		g.groups[prefix] = group
+	}
+	return group, rest
+}
+
+func (g *httpTreeMuxGroups) handle(method, path string, kind HandlerKind) {
+	group, path := g.group(path)
+	switch kind {
+	case HandlerContextWrite:
+		group.UsingContext().Handle(method, path, httpTreeMuxHandlerContextWrite)
+	case HandlerMethod:
+		group.UsingContext().Handle(method, path, MethodHandler(method))
+	default:
+		group.Handle(method, path, httpTreeMuxHandleFor(kind))
+	}
+}
+
+func loadHttpTreeMux(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	g := newHttpTreeMuxGroups()
+	for _, route := range routes {
+		g.handle(route.Method, route.Path, kind)
+	}
+	return g.router
+}
+
+func loadHttpTreeMuxSingle(method, path string, kind HandlerKind) http.Handler {
+	g := newHttpTreeMuxGroups()
+	g.handle(method, path, kind)
+	return g.router
+}
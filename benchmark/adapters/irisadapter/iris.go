@@ -0,0 +1,103 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package irisadapter registers Iris in the adapters registry when imported.
+// It is a module of its own, so that Iris and its dependencies are only
+// downloaded if it is benchmarked.
+package irisadapter
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/kataras/iris/v12"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "Iris",
+		Module: "github.com/kataras/iris/v12",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.BracePath,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadIris,
+		LoadSingle: loadIrisSingle,
+		TreeNode:   "router.trieNode",
+	})
+}
+
+func irisHandle(_ iris.Context) {}
+
+func irisHandleWrite(ctx iris.Context) {
+	io.WriteString(ctx, ctx.Params().Get("name"))
+}
+
+func irisHandleTest(ctx iris.Context) {
+	io.WriteString(ctx, ctx.Request().RequestURI)
+}
+
+func irisHandleParams(ctx iris.Context) {
+	params := make(map[string]string)
+	ctx.Params().Visit(func(key, value string) {
+		params[key] = value
+	})
+	adapters.WriteParams(ctx, params)
+}
+
+func irisHandleFor(kind adapters.HandlerKind) iris.Handler {
+	switch kind {
+	case adapters.HandlerWrite:
+		return irisHandleWrite
+	case adapters.HandlerTest:
+		return irisHandleTest
+	case adapters.HandlerParams:
+		return irisHandleParams
+	}
+	return irisHandle
+}
+
+// newIris returns an application without logging, which is built by
+// buildIris once its routes are registered.
+func newIris() *iris.Application {
+	app := iris.New()
+	app.Logger().SetLevel("disable")
+	return app
+}
+
+func buildIris(app *iris.Application) http.Handler {
+	if err := app.Build(); err != nil {
+		panic(err)
+	}
+	return app
+}
+
+func loadIris(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := irisHandleFor(kind)
+
+	app := newIris()
+	for _, route := range routes {
+		if kind == adapters.HandlerMethod {
+			app.Handle(route.Method, route.Path, iris.FromStd(adapters.MethodHandler(route.Method)))
+			continue
+		}
+		app.Handle(route.Method, route.Path, h)
+	}
+	return buildIris(app)
+}
+
+func loadIrisSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	app := newIris()
+	if kind == adapters.HandlerMethod {
+		app.Handle(method, path, iris.FromStd(adapters.MethodHandler(method)))
+		return buildIris(app)
+	}
+	app.Handle(method, path, irisHandleFor(kind))
+	return buildIris(app)
+}
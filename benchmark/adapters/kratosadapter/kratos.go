@@ -0,0 +1,112 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package kratosadapter registers Kratos in the adapters registry when
+// imported. It is a module of its own, so that Kratos and its dependencies are
+// only downloaded if it is benchmarked.
+package kratosadapter
+
+import (
+	"io"
+	"net/http"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/gorilla/mux"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	// Kratos routes with gorilla/mux, so the difference to GorillaMux is the
+	// overhead of its transport.
+	adapters.Register(adapters.Router{
+		Name:   "Kratos",
+		Module: "github.com/go-kratos/kratos",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Brace,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			CleanPaths:        true,
+			ContextParams:     true,
+		},
+		Load:       loadKratos,
+		LoadSingle: loadKratosSingle,
+	})
+}
+
+func kratosHandler(_ khttp.Context) error {
+	return nil
+}
+
+func kratosHandlerWrite(c khttp.Context) error {
+	_, err := io.WriteString(c.Response(), c.Vars().Get("name"))
+	return err
+}
+
+func kratosHandlerTest(c khttp.Context) error {
+	_, err := io.WriteString(c.Response(), c.Request().RequestURI)
+	return err
+}
+
+// kratosHandlerParams reads the params from gorilla/mux, as the context only
+// copies them into url.Values.
+func kratosHandlerParams(c khttp.Context) error {
+	adapters.WriteParams(c.Response(), mux.Vars(c.Request()))
+	return nil
+}
+
+// kratosHandlerContextWrite reads the params from the request context, where
+// gorilla/mux stores them.
+func kratosHandlerContextWrite(c khttp.Context) error {
+	_, err := io.WriteString(c.Response(), mux.Vars(c.Request())["name"])
+	return err
+}
+
+func kratosHandlerMethod(method string) khttp.HandlerFunc {
+	return func(c khttp.Context) error {
+		_, err := io.WriteString(c.Response(), method)
+		return err
+	}
+}
+
+func kratosHandlerFor(method string, kind adapters.HandlerKind) khttp.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return kratosHandlerWrite
+	case adapters.HandlerTest:
+		return kratosHandlerTest
+	case adapters.HandlerContextWrite:
+		return kratosHandlerContextWrite
+	case adapters.HandlerParams:
+		return kratosHandlerParams
+	case adapters.HandlerMethod:
+		return kratosHandlerMethod(method)
+	}
+	return kratosHandler
+}
+
+// newKratos returns a server, which is not started, as its handler serves the
+// requests without a listener. It does not redirect the paths with a trailing
+// slash to the routes without one and vice versa, like GorillaMux. The routes
+// are cleaned by Kratos, e.g. of their trailing slash.
+func newKratos() *khttp.Server {
+	return khttp.NewServer(khttp.StrictSlash(false))
+}
+
+func loadKratos(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	srv := newKratos()
+	router := srv.Route("/")
+	for _, route := range routes {
+		router.Handle(route.Method, route.Path, kratosHandlerFor(route.Method, kind))
+	}
+	return srv
+}
+
+func loadKratosSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	srv := newKratos()
+	srv.Route("/").Handle(method, path, kratosHandlerFor(method, kind))
+	return srv
+}
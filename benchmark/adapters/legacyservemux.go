@@ -0,0 +1,308 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "LegacyServeMux",
+		Module: "net/http",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.Colon,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadLegacyServeMux,
+		LoadSingle: loadLegacyServeMuxSingle,
+	})
+}
+
+// legacyServeMux is the baseline of the routers: a copy of the matching of the
+// ServeMux before Go 1.22, which matches static paths and the subtrees of
+// paths with a trailing slash, without methods and params. The net/http of Go
+// 1.22 and later only falls back to it for the whole program with the
+// httpmuxgo121 setting, which the ServeMux router rules out.
+//
+// Routes with params are registered as the subtree of their static prefix,
+// e.g. /user/ for /user/:name, whose handler matches the method and parses the
+// params from the path, as handlers of the ServeMux had to.
+type legacyServeMux struct {
+	// patterns maps every pattern to its handler.
+	patterns map[string]http.Handler
+	// subtrees are the patterns with a trailing slash, from the longest to
+	// the shortest.
+	subtrees []legacyServeMuxSubtree
+}
+
+type legacyServeMuxSubtree struct {
+	prefix string
+	h      http.Handler
+}
+
+// handle registers the handler for the pattern.
+func (mux *legacyServeMux) handle(pattern string, h http.Handler) {
+	if mux.patterns == nil {
+		mux.patterns = make(map[string]http.Handler)
+	}
+	mux.patterns[pattern] = h
+	if pattern[len(pattern)-1] != '/' {
+		return
+	}
+	i := sort.Search(len(mux.subtrees), func(i int) bool {
+		return len(mux.subtrees[i].prefix) < len(pattern)
+	})
+	mux.subtrees = append(mux.subtrees, legacyServeMuxSubtree{})
+	copy(mux.subtrees[i+1:], mux.subtrees[i:])
+	mux.subtrees[i] = legacyServeMuxSubtree{prefix: pattern, h: h}
+}
+
+// match returns the handler of the pattern matching the path, which is the
+// static pattern of the path or else the longest subtree containing it.
+func (mux *legacyServeMux) match(path string) http.Handler {
+	if h, ok := mux.patterns[path]; ok {
+		return h
+	}
+	for _, e := range mux.subtrees {
+		if strings.HasPrefix(path, e.prefix) {
+			return e.h
+		}
+	}
+	return nil
+}
+
+// ServeHTTP redirects requests for unclean paths and for paths of a subtree
+// without the trailing slash, like the ServeMux did, and serves the others by
+// the handler of the matching pattern.
+func (mux *legacyServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.RequestURI == "*" {
+		if r.ProtoAtLeast(1, 1) {
+			w.Header().Set("Connection", "close")
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	path := legacyServeMuxCleanPath(r.URL.Path)
+	if _, ok := mux.patterns[path]; !ok && path[len(path)-1] != '/' {
+		if _, ok := mux.patterns[path+"/"]; ok {
+			path += "/"
+		}
+	}
+	if path != r.URL.Path {
+		u := &url.URL{Path: path, RawQuery: r.URL.RawQuery}
+		http.Redirect(w, r, u.String(), http.StatusMovedPermanently)
+		return
+	}
+
+	h := mux.match(path)
+	if h == nil {
+		http.NotFound(w, r)
+		return
+	}
+	h.ServeHTTP(w, r)
+}
+
+// legacyServeMuxCleanPath returns the canonical path of p, eliminating . and
+// .. elements, but keeping a trailing slash.
+func legacyServeMuxCleanPath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	if p[0] != '/' {
+		p = "/" + p
+	}
+	np := path.Clean(p)
+	if p[len(p)-1] == '/' && np != "/" {
+		if len(p) == len(np)+1 && strings.HasPrefix(p, np) {
+			np = p
+		} else {
+			np += "/"
+		}
+	}
+	return np
+}
+
+// legacyServeMuxRoute is a route in the canonical syntax, split into its
+// segments.
+type legacyServeMuxRoute struct {
+	method   string
+	segments []string
+	// names are the names of the params of the route, in the order of their
+	// segments.
+	names []string
+	// params is set if the handler reads the params.
+	params bool
+	serve  func(w http.ResponseWriter, r *http.Request, route *legacyServeMuxRoute, values []string)
+}
+
+// match matches the path against the segments of the route and returns the
+// values of its params in the order of their names, found on the way, if the
+// handler reads them.
+func (route *legacyServeMuxRoute) match(path string) (values []string, ok bool) {
+	if route.params && len(route.names) > 0 {
+		values = make([]string, 0, len(route.names))
+	}
+	for _, seg := range route.segments {
+		if len(path) == 0 || path[0] != '/' {
+			return nil, false
+		}
+		path = path[1:]
+
+		if isParam(seg) && seg[0] == '*' {
+			if values != nil {
+				values = append(values, path)
+			}
+			return values, true
+		}
+
+		n := strings.IndexByte(path, '/')
+		if n < 0 {
+			n = len(path)
+		}
+		if isParam(seg) {
+			if n == 0 {
+				return nil, false
+			}
+			if values != nil {
+				values = append(values, path[:n])
+			}
+		} else if path[:n] != seg {
+			return nil, false
+		}
+		path = path[n:]
+	}
+	return values, path == ""
+}
+
+// legacyServeMuxPattern is the handler of a pattern of the ServeMux, which
+// serves the first route matching the request.
+type legacyServeMuxPattern struct {
+	routes []*legacyServeMuxRoute
+}
+
+func (p *legacyServeMuxPattern) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for _, route := range p.routes {
+		if route.method != r.Method {
+			continue
+		}
+		if values, ok := route.match(r.URL.Path); ok {
+			route.serve(w, r, route, values)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+func legacyServeMuxHandleWrite(w http.ResponseWriter, r *http.Request, route *legacyServeMuxRoute, values []string) {
+	for i, name := range route.names {
+		if name == "name" {
+			io.WriteString(w, values[i])
+			return
+		}
+	}
+}
+
+func legacyServeMuxHandleParams(w http.ResponseWriter, r *http.Request, route *legacyServeMuxRoute, values []string) {
+	params := make(map[string]string, len(route.names))
+	for i, name := range route.names {
+		params[name] = values[i]
+	}
+	WriteParams(w, params)
+}
+
+// legacyServeMuxHandler wraps a handler, which does not read the params.
+func legacyServeMuxHandler(h http.HandlerFunc) func(http.ResponseWriter, *http.Request, *legacyServeMuxRoute, []string) {
+	return func(w http.ResponseWriter, r *http.Request, _ *legacyServeMuxRoute, _ []string) {
+		h(w, r)
+	}
+}
+
+func legacyServeMuxHandleFor(method string, kind HandlerKind) func(http.ResponseWriter, *http.Request, *legacyServeMuxRoute, []string) {
+	switch kind {
+	case HandlerWrite:
+		return legacyServeMuxHandleWrite
+	case HandlerTest:
+		return legacyServeMuxHandler(httpHandlerFuncTest)
+	case HandlerParams:
+		return legacyServeMuxHandleParams
+	case HandlerMethod:
+		return legacyServeMuxHandler(MethodHandler(method))
+	}
+	return legacyServeMuxHandler(httpHandlerFunc)
+}
+
+// legacyServeMuxPrefix returns the pattern of a route: its path if it is
+// static, or else its prefix up to the first param as a subtree.
+func legacyServeMuxPrefix(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		if isParam(seg) {
+			return strings.Join(segments[:i], "/") + "/"
+		}
+	}
+	return path
+}
+
+func loadLegacyServeMux(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	patterns := make(map[string]*legacyServeMuxPattern)
+	for _, r := range routes {
+		prefix := legacyServeMuxPrefix(r.Path)
+		p, ok := patterns[prefix]
+		if !ok {
+			p = new(legacyServeMuxPattern)
+			patterns[prefix] = p
+		}
+		route := &legacyServeMuxRoute{
+			method:   r.Method,
+			segments: strings.Split(r.Path, "/")[1:],
+			params:   kind == HandlerWrite || kind == HandlerParams,
+			serve:    legacyServeMuxHandleFor(r.Method, kind),
+		}
+		for _, seg := range route.segments {
+			if isParam(seg) {
+				route.names = append(route.names, seg[1:])
+			}
+		}
+		p.routes = append(p.routes, route)
+	}
+
+	// The ServeMux serves the longest pattern matching the path, thus the
+	// routes of the subtrees containing a pattern are appended to it, from the
+	// longest subtree to the shortest, e.g. GET /user/:name to the pattern
+	// /user/new, which might only have a POST route.
+	prefixes := make([]string, 0, len(patterns))
+	for prefix := range patterns {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+	mux := new(legacyServeMux)
+	for _, prefix := range prefixes {
+		routes := append([]*legacyServeMuxRoute(nil), patterns[prefix].routes...)
+		for _, subtree := range prefixes {
+			if subtree != prefix && strings.HasSuffix(subtree, "/") && strings.HasPrefix(prefix, subtree) {
+				routes = append(routes, patterns[subtree].routes...)
+			}
+		}
+		mux.handle(prefix, &legacyServeMuxPattern{routes: routes})
+	}
+	return mux
+}
+
+func loadLegacyServeMuxSingle(method, path string, kind HandlerKind) http.Handler {
+	return loadLegacyServeMux([]fixtures.Route{{Method: method, Path: path}}, kind)
+}
@@ -0,0 +1,12 @@
+module github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters/macaronadapter
+
+go 1.13
+
+require (
+	github.com/julienschmidt/go-http-routing-benchmark v0.0.0-00010101000000-000000000000
+	github.com/unknwon/com v1.0.1 // indirect
+	gopkg.in/ini.v1 v1.48.0 // indirect
+	gopkg.in/macaron.v1 v1.3.4
+)
+
+replace github.com/julienschmidt/go-http-routing-benchmark => ../../..
@@ -0,0 +1,119 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package macaronadapter registers Macaron in the adapters registry when imported.
+// It is a module of its own, so that Macaron and its dependencies are only
+// downloaded if it is benchmarked.
+package macaronadapter
+
+import (
+	"net/http"
+	"strings"
+
+	"gopkg.in/macaron.v1"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "Macaron",
+		Module: "gopkg.in/macaron.v1",
+		Capabilities: adapters.Capabilities{
+			Dialect:           macaronDialect,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadMacaron,
+		LoadSingle: loadMacaronSingle,
+	})
+}
+
+// macaronDialect is the colon-star syntax with alphanumeric param names, as
+// Macaron takes e.g. /:client_id for the param client followed by "_id".
+var macaronDialect = pathsyntax.Dialect{
+	Name: "macaron",
+	Param: func(name string) string {
+		return ":" + strings.Map(func(r rune) rune {
+			if r < '0' || r > '9' && r < 'A' || r > 'Z' && r < 'a' || r > 'z' {
+				return -1
+			}
+			return r
+		}, name)
+	},
+	CatchAll: pathsyntax.ColonStar.CatchAll,
+}
+
+func macaronHandler() {}
+
+func macaronHandlerWrite(c *macaron.Context) string {
+	return c.Params("name")
+}
+
+func macaronHandlerTest(c *macaron.Context) string {
+	return c.Req.RequestURI
+}
+
+// macaronHandlerParams returns the HandlerParams handler of a route, which
+// reads the params by the names in the path, as Macaron does not provide all
+// params of a request.
+func macaronHandlerParams(path string) macaron.Handler {
+	var names []string
+	for _, seg := range strings.Split(path, "/") {
+		if strings.HasPrefix(seg, ":") || seg == "*" {
+			names = append(names, seg)
+		}
+	}
+	return func(c *macaron.Context) {
+		params := make(map[string]string, len(names))
+		for _, name := range names {
+			params[strings.TrimPrefix(name, ":")] = c.Params(name)
+		}
+		adapters.WriteParams(c.Resp, params)
+	}
+}
+
+func macaronHandlerFor(kind adapters.HandlerKind) macaron.Handler {
+	switch kind {
+	case adapters.HandlerWrite:
+		return macaronHandlerWrite
+	case adapters.HandlerTest:
+		return macaronHandlerTest
+	}
+	return macaronHandler
+}
+
+func loadMacaron(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	h := []macaron.Handler{macaronHandlerFor(kind)}
+
+	m := macaron.New()
+	for _, route := range routes {
+		switch kind {
+		case adapters.HandlerParams:
+			m.Handle(route.Method, route.Path, []macaron.Handler{macaronHandlerParams(route.Path)})
+			continue
+		case adapters.HandlerMethod:
+			m.Handle(route.Method, route.Path, []macaron.Handler{adapters.MethodHandler(route.Method)})
+			continue
+		}
+		m.Handle(route.Method, route.Path, h)
+	}
+	return m
+}
+
+func loadMacaronSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	m := macaron.New()
+	switch kind {
+	case adapters.HandlerParams:
+		m.Handle(method, path, []macaron.Handler{macaronHandlerParams(path)})
+		return m
+	case adapters.HandlerMethod:
+		m.Handle(method, path, []macaron.Handler{adapters.MethodHandler(method)})
+		return m
+	}
+	m.Handle(method, path, []macaron.Handler{macaronHandlerFor(kind)})
+	return m
+}
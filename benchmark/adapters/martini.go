@@ -0,0 +1,101 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-martini/martini"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "Martini",
+		Module: "github.com/go-martini/martini",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.ColonDoubleStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadMartini,
+		LoadSingle: loadMartiniSingle,
+	})
+}
+
+// martiniHandlerWrite gets the params injected by Martini, which resolves the
+// arguments of the handlers by their types.
+func martiniHandlerWrite(w http.ResponseWriter, params martini.Params) {
+	io.WriteString(w, params["name"])
+}
+
+func martiniHandlerParams(w http.ResponseWriter, params martini.Params) {
+	WriteParams(w, params)
+}
+
+func martiniHandlerFor(kind HandlerKind) martini.Handler {
+	switch kind {
+	case HandlerWrite:
+		return martiniHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return martiniHandlerParams
+	}
+	return httpHandlerFunc
+}
+
+func martiniHandle(router martini.Router, method, path string, h martini.Handler) {
+	switch method {
+	case "GET":
+		router.Get(path, h)
+	case "POST":
+		router.Post(path, h)
+	case "PUT":
+		router.Put(path, h)
+	case "PATCH":
+		router.Patch(path, h)
+	case "DELETE":
+		router.Delete(path, h)
+	default:
+		panic("Unknown HTTP method: " + method)
+	}
+}
+
+// newMartini returns a bare Martini without the logging and recovery
+// middleware of martini.Classic, which only dispatches to the router.
+func newMartini(router martini.Router) http.Handler {
+	m := martini.New()
+	m.MapTo(router, (*martini.Routes)(nil))
+	m.Action(router.Handle)
+	return m
+}
+
+func loadMartini(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	h := martiniHandlerFor(kind)
+
+	router := martini.NewRouter()
+	for _, route := range routes {
+		if kind == HandlerMethod {
+			martiniHandle(router, route.Method, route.Path, MethodHandler(route.Method))
+			continue
+		}
+		martiniHandle(router, route.Method, route.Path, h)
+	}
+	return newMartini(router)
+}
+
+func loadMartiniSingle(method, path string, kind HandlerKind) http.Handler {
+	router := martini.NewRouter()
+	if kind == HandlerMethod {
+		martiniHandle(router, method, path, MethodHandler(method))
+		return newMartini(router)
+	}
+	martiniHandle(router, method, path, martiniHandlerFor(kind))
+	return newMartini(router)
+}
@@ -0,0 +1,96 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/kataras/muxie"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "Muxie",
+		Module: "github.com/kataras/muxie",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.ColonStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadMuxie,
+		LoadSingle: loadMuxieSingle,
+		TreeNode:   "muxie.Node",
+	})
+}
+
+// muxieHandlerWrite reads the params from the response writer, in which muxie
+// stores them.
+func muxieHandlerWrite(w http.ResponseWriter, _ *http.Request) {
+	io.WriteString(w, muxie.GetParam(w, "name"))
+}
+
+func muxieHandlerParams(w http.ResponseWriter, _ *http.Request) {
+	params := make(map[string]string)
+	for _, p := range muxie.GetParams(w) {
+		params[p.Key] = p.Value
+	}
+	WriteParams(w, params)
+}
+
+func muxieHandlerFor(method string, kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite:
+		return muxieHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return muxieHandlerParams
+	case HandlerMethod:
+		return MethodHandler(method)
+	}
+	return httpHandlerFunc
+}
+
+// muxieMux registers the routes of a path with a single muxie.MethodHandler,
+// as muxie matches the paths only and leaves the methods to the handlers.
+type muxieMux struct {
+	mux     *muxie.Mux
+	methods map[string]*muxie.MethodHandler
+}
+
+func newMuxieMux() *muxieMux {
+	return &muxieMux{
+		mux:     muxie.NewMux(),
+		methods: make(map[string]*muxie.MethodHandler),
+	}
+}
+
+func (m *muxieMux) handle(method, path string, kind HandlerKind) {
+	methods, ok := m.methods[path]
+	if !ok {
+		methods = muxie.Methods()
+		m.methods[path] = methods
+		m.mux.Handle(path, methods)
+	}
+	methods.HandleFunc(method, muxieHandlerFor(method, kind))
+}
+
+func loadMuxie(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	m := newMuxieMux()
+	for _, route := range routes {
+		m.handle(route.Method, route.Path, kind)
+	}
+	return m.mux
+}
+
+func loadMuxieSingle(method, path string, kind HandlerKind) http.Handler {
+	m := newMuxieMux()
+	m.handle(method, path, kind)
+	return m.mux
+}
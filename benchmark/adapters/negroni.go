@@ -0,0 +1,47 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"net/http"
+
+	"github.com/urfave/negroni"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "NegroniHttpRouter",
+		Module: "github.com/urfave/negroni",
+		Capabilities: Capabilities{
+			Dialect:       pathsyntax.Colon,
+			CatchAll:      true,
+			ContextParams: true,
+		},
+		Load:       loadNegroniHttpRouter,
+		LoadSingle: loadNegroniHttpRouterSingle,
+		TreeNode:   "httprouter.node",
+	})
+}
+
+// NegroniHttpRouter is HttpRouter behind a typical middleware stack of
+// negroni, which shows the costs of the stack on top of the bare router. The
+// stack of negroni.Classic is used without the logger, which would write
+// every request, and without the static file server.
+func newNegroni(router http.Handler) http.Handler {
+	n := negroni.New(negroni.NewRecovery())
+	n.UseHandler(router)
+	return n
+}
+
+func loadNegroniHttpRouter(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	return newNegroni(loadHttpRouter(routes, kind))
+}
+
+func loadNegroniHttpRouterSingle(method, path string, kind HandlerKind) http.Handler {
+	return newNegroni(loadHttpRouterSingle(method, path, kind))
+}
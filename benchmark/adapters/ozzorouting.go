@@ -0,0 +1,91 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	routing "github.com/go-ozzo/ozzo-routing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "OzzoRouting",
+		Module: "github.com/go-ozzo/ozzo-routing",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.Angle,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadOzzoRouting,
+		LoadSingle: loadOzzoRoutingSingle,
+		TreeNode:   "routing.node",
+	})
+}
+
+func ozzoRoutingHandle(_ *routing.Context) error { return nil }
+
+func ozzoRoutingHandleWrite(c *routing.Context) error {
+	io.WriteString(c.Response, c.Param("name"))
+	return nil
+}
+
+func ozzoRoutingHandleTest(c *routing.Context) error {
+	io.WriteString(c.Response, c.Request.RequestURI)
+	return nil
+}
+
+// ozzoRoutingHandleParams returns the HandlerParams handler of a route, which
+// knows the names of the params of the route, as ozzo-routing only provides
+// the params by their names.
+func ozzoRoutingHandleParams(path string) routing.Handler {
+	names := ParamNames(path, pathsyntax.Angle)
+	return func(c *routing.Context) error {
+		params := make(map[string]string, len(names))
+		for _, name := range names {
+			params[name] = c.Param(name)
+		}
+		WriteParams(c.Response, params)
+		return nil
+	}
+}
+
+// ozzoRoutingHandleFor returns the handler of a route, as some handlers depend
+// on the route.
+func ozzoRoutingHandleFor(method, path string, kind HandlerKind) routing.Handler {
+	switch kind {
+	case HandlerWrite:
+		return ozzoRoutingHandleWrite
+	case HandlerTest:
+		return ozzoRoutingHandleTest
+	case HandlerParams:
+		return ozzoRoutingHandleParams(path)
+	case HandlerMethod:
+		return routing.HTTPHandlerFunc(MethodHandler(method))
+	}
+	return ozzoRoutingHandle
+}
+
+func ozzoRoutingAdd(router *routing.Router, method, path string, kind HandlerKind) {
+	router.To(method, path, ozzoRoutingHandleFor(method, path, kind))
+}
+
+func loadOzzoRouting(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	router := routing.New()
+	for _, route := range routes {
+		ozzoRoutingAdd(router, route.Method, route.Path, kind)
+	}
+	return router
+}
+
+func loadOzzoRoutingSingle(method, path string, kind HandlerKind) http.Handler {
+	router := routing.New()
+	ozzoRoutingAdd(router, method, path, kind)
+	return router
+}
@@ -0,0 +1,83 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bmizerany/pat"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "Pat",
+		Module: "github.com/bmizerany/pat",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.Colon,
+			ConflictingRoutes: true,
+		},
+		Load:       loadPat,
+		LoadSingle: loadPatSingle,
+	})
+}
+
+// patHandlerWrite reads the params from the query of the request, into which
+// pat writes them prefixed with a colon.
+func patHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.URL.Query().Get(":name"))
+}
+
+func patHandlerParams(w http.ResponseWriter, r *http.Request) {
+	params := make(map[string]string)
+	for name, values := range r.URL.Query() {
+		if strings.HasPrefix(name, ":") {
+			params[name[1:]] = values[0]
+		}
+	}
+	WriteParams(w, params)
+}
+
+func patHandlerFor(kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite:
+		return patHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return patHandlerParams
+	}
+	return httpHandlerFunc
+}
+
+// loadPat registers the routes with escaped paths, as pat matches the escaped
+// paths of the requests.
+func loadPat(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	h := patHandlerFor(kind)
+
+	m := pat.New()
+	for _, route := range routes {
+		if kind == HandlerMethod {
+			m.Add(route.Method, EscapePath(route.Path), MethodHandler(route.Method))
+			continue
+		}
+		m.Add(route.Method, EscapePath(route.Path), h)
+	}
+	return m
+}
+
+func loadPatSingle(method, path string, kind HandlerKind) http.Handler {
+	m := pat.New()
+	if kind == HandlerMethod {
+		m.Add(method, EscapePath(path), MethodHandler(method))
+		return m
+	}
+	m.Add(method, EscapePath(path), patHandlerFor(kind))
+	return m
+}
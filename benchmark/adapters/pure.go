@@ -0,0 +1,88 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/pure"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "Pure",
+		Module: "github.com/go-playground/pure",
+		Capabilities: Capabilities{
+			Dialect:       pathsyntax.ColonStar,
+			CatchAll:      true,
+			ContextParams: true,
+		},
+		Load:       loadPure,
+		LoadSingle: loadPureSingle,
+		TreeNode:   "pure.node",
+	})
+}
+
+// pureHandlerWrite reads the params from the request context, since this is
+// the only way pure provides them.
+func pureHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, pure.RequestVars(r).URLParam("name"))
+}
+
+// pureHandlerParams returns the HandlerParams handler of a route path, as pure
+// only provides the params by their names. The catch-all param is always
+// named pure.WildcardParam.
+func pureHandlerParams(path string) http.HandlerFunc {
+	var names []string
+	for _, seg := range strings.Split(path, "/") {
+		switch {
+		case strings.HasPrefix(seg, ":"):
+			names = append(names, seg[1:])
+		case seg == "*":
+			names = append(names, pure.WildcardParam)
+		}
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := pure.RequestVars(r)
+		params := make(map[string]string, len(names))
+		for _, name := range names {
+			params[name] = vars.URLParam(name)
+		}
+		WriteParams(w, params)
+	}
+}
+
+func pureHandlerFor(method, path string, kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite, HandlerContextWrite:
+		return pureHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return pureHandlerParams(path)
+	case HandlerMethod:
+		return MethodHandler(method)
+	}
+	return httpHandlerFunc
+}
+
+func loadPure(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	p := pure.New()
+	for _, route := range routes {
+		p.Handle(route.Method, route.Path, pureHandlerFor(route.Method, route.Path, kind))
+	}
+	return p.Serve()
+}
+
+func loadPureSingle(method, path string, kind HandlerKind) http.Handler {
+	p := pure.New()
+	p.Handle(method, path, pureHandlerFor(method, path, kind))
+	return p.Serve()
+}
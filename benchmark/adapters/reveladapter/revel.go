@@ -0,0 +1,220 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package reveladapter registers Revel in the adapters registry when imported.
+// It is a module of its own, so that Revel and its config, logging and
+// template dependencies are only downloaded if it is benchmarked. Unlike the
+// other frameworks, it is only selected by the revel build tag:
+//
+//	go test -tags revel -bench=/Revel/
+package reveladapter
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+
+	"github.com/revel/revel"
+	"github.com/revel/revel/logger"
+	"github.com/revel/revel/model"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	initRevel()
+
+	adapters.Register(adapters.Router{
+		Name:   "Revel",
+		Module: "github.com/revel/revel",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Colon,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+		},
+		Load:       loadRevel,
+		LoadSingle: loadRevelSingle,
+		TreeNode:   "pathtree.Node",
+	})
+}
+
+// Revel (Router only)
+// In the following code some Revel internals are modeled.
+// The original revel code is copyrighted by Rob Figueiredo.
+// See https://github.com/revel/revel/blob/master/LICENSE
+
+// revelController is the controller of the routes. Revel instantiates it per
+// request and calls its actions through reflection.
+type revelController struct {
+	*revel.Controller
+}
+
+func (rc *revelController) Handle() revel.Result {
+	return revelResult{}
+}
+
+func (rc *revelController) HandleWrite() revel.Result {
+	return rc.RenderText(rc.Params.Route.Get("name"))
+}
+
+func (rc *revelController) HandleTest() revel.Result {
+	return rc.RenderText(rc.Request.GetRequestURI())
+}
+
+func (rc *revelController) HandleParams() revel.Result {
+	params := make(map[string]string, len(rc.Params.Route))
+	for name := range rc.Params.Route {
+		params[name] = rc.Params.Route.Get(name)
+	}
+	return revelParamsResult(params)
+}
+
+// HandleMethod gets the method of the route as fixed param, since a GET route
+// serves HEAD requests as well.
+func (rc *revelController) HandleMethod(method string) revel.Result {
+	return rc.RenderText(method)
+}
+
+type revelResult struct{}
+
+func (rr revelResult) Apply(req *revel.Request, resp *revel.Response) {}
+
+type revelParamsResult map[string]string
+
+func (rr revelParamsResult) Apply(req *revel.Request, resp *revel.Response) {
+	resp.WriteHeader(http.StatusOK, "text/plain; charset=utf-8")
+	adapters.WriteParams(resp.GetWriter(), rr)
+}
+
+// revelRouter serves the requests like the server engine of Revel, but only
+// runs the filters required for routing.
+type revelRouter struct {
+	router *revel.Router
+	engine *revel.GoHttpServer
+}
+
+func (rr *revelRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Dirty hacks, do NOT copy!
+	revel.MainRouter = rr.router
+
+	ctx := revel.NewGoContext(rr.engine)
+	ctx.Request.SetRequest(r)
+	ctx.Response.SetResponse(w)
+
+	c := revel.NewController(ctx)
+	c.Log = revel.AppLog
+	revel.Filters[0](c, revel.Filters[1:])
+	if _, ok := c.Result.(revel.ErrorResult); ok {
+		// the ErrorResult renders the error templates of an app, which the
+		// router has not, e.g. for routes not found
+		http.Error(w, http.StatusText(c.Response.Status), c.Response.Status)
+	} else if c.Result != nil {
+		c.Result.Apply(c.Request, c.Response)
+	} else if c.Response.Status != 0 {
+		c.Response.SetStatus(c.Response.Status)
+	}
+	// Close the Writer if we can
+	if w, ok := c.Response.GetWriter().(io.Closer); ok {
+		w.Close()
+	}
+}
+
+func initRevel() {
+	// Discard the log of Revel, which logs every route added at the debug
+	// level
+	revel.RootLog.SetHandler(logger.NilHandler())
+
+	// The config of revel.Init, without reusing the controllers
+	revel.RevelConfig = &model.RevelContainer{}
+
+	// Only use the Revel filters required for this benchmark
+	revel.Filters = []revel.Filter{
+		revel.RouterFilter,
+		revel.ParamsFilter,
+		revel.ActionInvoker,
+	}
+
+	revel.RegisterController((*revelController)(nil),
+		[]*revel.MethodType{
+			{
+				Name: "Handle",
+			},
+			{
+				Name: "HandleWrite",
+			},
+			{
+				Name: "HandleTest",
+			},
+			{
+				Name: "HandleParams",
+			},
+			{
+				Name: "HandleMethod",
+				Args: []*revel.MethodArg{
+					{Name: "method", Type: reflect.TypeOf((*string)(nil))},
+				},
+			},
+		})
+}
+
+func revelActionFor(kind adapters.HandlerKind) string {
+	switch kind {
+	case adapters.HandlerWrite:
+		return "revelController.HandleWrite"
+	case adapters.HandlerTest:
+		return "revelController.HandleTest"
+	case adapters.HandlerParams:
+		return "revelController.HandleParams"
+	case adapters.HandlerMethod:
+		return "revelController.HandleMethod"
+	}
+	return "revelController.Handle"
+}
+
+// newRevelRoute returns the route for the action, which gets the method as
+// fixed param for HandlerMethod.
+func newRevelRoute(method, path string, kind adapters.HandlerKind) *revel.Route {
+	var fixedArgs string
+	if kind == adapters.HandlerMethod {
+		fixedArgs = `"` + method + `"`
+	}
+	return revel.NewRoute(nil, method, path, revelActionFor(kind), fixedArgs, "", 0)
+}
+
+// newRevelRouter builds the tree of the router like Router.updateTree, which
+// is only run for the routes file.
+func newRevelRouter(routes []*revel.Route) http.Handler {
+	router := revel.NewRouter("")
+	router.Routes = routes
+	for _, r := range routes {
+		err := router.Tree.Add(r.TreePath, []*revel.Route{r})
+		// Allow GETs to respond to HEAD requests.
+		if err == nil && r.Method == "GET" {
+			err = router.Tree.Add("/HEAD"+r.Path, []*revel.Route{r})
+		}
+		// Error adding a route to the pathtree.
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return &revelRouter{
+		router: router,
+		engine: &revel.GoHttpServer{MaxMultipartSize: 32 << 20},
+	}
+}
+
+func loadRevel(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	rs := make([]*revel.Route, 0, len(routes))
+	for _, r := range routes {
+		rs = append(rs, newRevelRoute(r.Method, r.Path, kind))
+	}
+	return newRevelRouter(rs)
+}
+
+func loadRevelSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	return newRevelRouter([]*revel.Route{newRevelRoute(method, path, kind)})
+}
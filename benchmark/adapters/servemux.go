@@ -0,0 +1,97 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build go1.22
+// +build go1.22
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+// The patterns of the ServeMux with methods and params require Go 1.22. For
+// modules declaring an older go version, like this one, they must be enabled
+// by //go:debug httpmuxgo121=0 in the main package.
+func init() {
+	Register(Router{
+		Name:   "ServeMux",
+		Module: "net/http",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.BraceRemainder,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			IdentifierParams:  true,
+			CleanPaths:        true,
+		},
+		Load:       loadServeMux,
+		LoadSingle: loadServeMuxSingle,
+	})
+}
+
+// serveMuxHandlerWrite reads the param from the request, in which the
+// ServeMux stores the params since Go 1.22.
+func serveMuxHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, r.PathValue("name"))
+}
+
+// serveMuxHandlerParams returns the HandlerParams handler of a route, which
+// knows the names of the params of the route, as the ServeMux only provides
+// the params by their names.
+func serveMuxHandlerParams(path string) http.HandlerFunc {
+	names := ParamNames(path, pathsyntax.BraceRemainder)
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := make(map[string]string, len(names))
+		for _, name := range names {
+			params[name] = r.PathValue(name)
+		}
+		WriteParams(w, params)
+	}
+}
+
+// serveMuxHandlerFor returns the handler of a route, as some handlers depend
+// on the route.
+func serveMuxHandlerFor(method, path string, kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite:
+		return serveMuxHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return serveMuxHandlerParams(path)
+	case HandlerMethod:
+		return MethodHandler(method)
+	}
+	return httpHandlerFunc
+}
+
+// serveMuxHandle registers the route with its method. Paths with a trailing
+// slash are anchored by {$}, as they would match every path below them
+// otherwise.
+func serveMuxHandle(mux *http.ServeMux, method, path string, kind HandlerKind) {
+	pattern := path
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "{$}"
+	}
+	mux.HandleFunc(method+" "+pattern, serveMuxHandlerFor(method, path, kind))
+}
+
+func loadServeMux(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	mux := http.NewServeMux()
+	for _, route := range routes {
+		serveMuxHandle(mux, route.Method, route.Path, kind)
+	}
+	return mux
+}
+
+func loadServeMuxSingle(method, path string, kind HandlerKind) http.Handler {
+	mux := http.NewServeMux()
+	serveMuxHandle(mux, method, path, kind)
+	return mux
+}
@@ -0,0 +1,94 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"net/http"
+	"reflect"
+)
+
+// TreeStats describe the tree of a loaded router.
+type TreeStats struct {
+	Nodes  int
+	Depth  int     // of the deepest node, the roots have a depth of 1
+	FanOut float64 // average number of children of the inner nodes
+}
+
+// Tree returns the statistics of the tree of a router loaded by r, which are
+// collected by walking the unexported fields of the router with reflection.
+// ok is false if the router has no TreeNode type or h contains no nodes.
+func (r Router) Tree(h http.Handler) (stats TreeStats, ok bool) {
+	if r.TreeNode == "" {
+		return TreeStats{}, false
+	}
+	w := treeWalker{node: r.TreeNode, visited: make(map[uintptr]bool)}
+
+	inner, children := 0, 0
+	var walk func(n reflect.Value, depth int)
+	walk = func(n reflect.Value, depth int) {
+		stats.Nodes++
+		if depth > stats.Depth {
+			stats.Depth = depth
+		}
+		kids := w.nodes(n, false)
+		if len(kids) > 0 {
+			inner++
+			children += len(kids)
+		}
+		for _, kid := range kids {
+			walk(kid, depth+1)
+		}
+	}
+	for _, root := range w.nodes(reflect.ValueOf(h), false) {
+		walk(root, 1)
+	}
+	if inner > 0 {
+		stats.FanOut = float64(children) / float64(inner)
+	}
+	return stats, stats.Nodes > 0
+}
+
+// treeWalker finds the nodes of a tree in the values of a router.
+type treeWalker struct {
+	node    string // type of the nodes, e.g. "httprouter.node"
+	visited map[uintptr]bool
+}
+
+// nodes returns the nodes reachable from v, which are not visited yet, without
+// passing other nodes. If v is a node, it is returned if match is set, and its
+// children otherwise.
+func (w *treeWalker) nodes(v reflect.Value, match bool) []reflect.Value {
+	if match && v.Type().String() == w.node {
+		return []reflect.Value{v}
+	}
+
+	var nodes []reflect.Value
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || w.visited[v.Pointer()] {
+			return nil
+		}
+		w.visited[v.Pointer()] = true
+		return w.nodes(v.Elem(), true)
+	case reflect.Interface:
+		if !v.IsNil() {
+			return w.nodes(v.Elem(), true)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			nodes = append(nodes, w.nodes(v.Field(i), true)...)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			nodes = append(nodes, w.nodes(v.Index(i), true)...)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			nodes = append(nodes, w.nodes(iter.Value(), true)...)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,52 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"net/http"
+	"testing"
+)
+
+// node is a node of the tree of treeRouter, with a reference to its parent,
+// which is not a child.
+type node struct {
+	parent   *node
+	children []*node
+}
+
+func (n *node) add(children ...*node) *node {
+	for _, child := range children {
+		child.parent = n
+	}
+	n.children = append(n.children, children...)
+	return n
+}
+
+type treeRouter struct {
+	trees map[string]*node
+	http.Handler
+}
+
+func TestTree(t *testing.T) {
+	// GET: depth 3, POST: depth 1
+	get := new(node).add(
+		new(node).add(new(node), new(node), new(node)),
+		new(node),
+	)
+	h := &treeRouter{trees: map[string]*node{"GET": get, "POST": new(node)}}
+
+	stats, ok := Router{TreeNode: "adapters.node"}.Tree(h)
+	want := TreeStats{Nodes: 7, Depth: 3, FanOut: 2.5}
+	if !ok || stats != want {
+		t.Errorf("Tree = %+v, %v, want %+v, true", stats, ok, want)
+	}
+
+	if _, ok := (Router{}).Tree(h); ok {
+		t.Error("Tree of a router without TreeNode is ok")
+	}
+	if _, ok := (Router{TreeNode: "adapters.leaf"}).Tree(h); ok {
+		t.Error("Tree of a router without nodes of TreeNode is ok")
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/husobee/vestigo"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "Vestigo",
+		Module: "github.com/husobee/vestigo",
+		Capabilities: Capabilities{
+			Dialect:           pathsyntax.ColonStar,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			SharedParamNames:  true,
+		},
+		Load:       loadVestigo,
+		LoadSingle: loadVestigoSingle,
+	})
+}
+
+// vestigoHandlerWrite reads the params from the query of the request, into
+// which vestigo writes them prefixed with a colon.
+func vestigoHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, vestigo.Param(r, "name"))
+}
+
+func vestigoHandlerParams(w http.ResponseWriter, r *http.Request) {
+	names := vestigo.TrimmedParamNames(r)
+	params := make(map[string]string, len(names))
+	for _, name := range names {
+		params[name] = vestigo.Param(r, name)
+	}
+	WriteParams(w, params)
+}
+
+func vestigoHandlerFor(method string, kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite:
+		return vestigoHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return vestigoHandlerParams
+	case HandlerMethod:
+		return MethodHandler(method)
+	}
+	return httpHandlerFunc
+}
+
+// loadVestigo leaves CORS turned off, as it is without a global policy, so
+// that vestigo only checks the method of the resource a request matches.
+func loadVestigo(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	router := vestigo.NewRouter()
+	for _, route := range routes {
+		router.Add(route.Method, route.Path, vestigoHandlerFor(route.Method, kind))
+	}
+	return router
+}
+
+func loadVestigoSingle(method, path string, kind HandlerKind) http.Handler {
+	router := vestigo.NewRouter()
+	router.Add(method, path, vestigoHandlerFor(method, kind))
+	return router
+}
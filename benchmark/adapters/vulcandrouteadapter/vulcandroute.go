@@ -0,0 +1,140 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package vulcandrouteadapter registers vulcand/route in the adapters registry
+// when imported. It is a module of its own, so that vulcand/route and the
+// expression parser it requires are only downloaded if it is benchmarked.
+package vulcandrouteadapter
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/vulcand/route"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	adapters.Register(adapters.Router{
+		Name:   "VulcandRoute",
+		Module: "github.com/vulcand/route",
+		Capabilities: adapters.Capabilities{
+			Dialect:           pathsyntax.Angle,
+			ConflictingRoutes: true,
+		},
+		Load:       loadVulcandRoute,
+		LoadSingle: loadVulcandRouteSingle,
+	})
+}
+
+// vulcand/route matches the params of the routes, but does not pass them on to
+// the handlers. Thus the handlers of a route take the params from the segments
+// of the path of the request at the positions of the params of the route.
+
+// vulcandRouteParam is a param of a route at the position of its segment.
+type vulcandRouteParam struct {
+	name    string
+	segment int
+}
+
+// vulcandRouteParams returns the params of a route path in the dialect.
+func vulcandRouteParams(path string) []vulcandRouteParam {
+	var params []vulcandRouteParam
+	for i, seg := range strings.Split(path, "/") {
+		if strings.HasPrefix(seg, "<") && strings.HasSuffix(seg, ">") {
+			params = append(params, vulcandRouteParam{seg[1 : len(seg)-1], i})
+		}
+	}
+	return params
+}
+
+// vulcandRouteSegment returns the segment i of the path.
+func vulcandRouteSegment(path string, i int) string {
+	for ; i > 0; i-- {
+		n := strings.IndexByte(path, '/')
+		if n < 0 {
+			return ""
+		}
+		path = path[n+1:]
+	}
+	if n := strings.IndexByte(path, '/'); n >= 0 {
+		return path[:n]
+	}
+	return path
+}
+
+func vulcandRouteHandlerWrite(params []vulcandRouteParam) http.HandlerFunc {
+	segment := -1
+	for _, p := range params {
+		if p.name == "name" {
+			segment = p.segment
+		}
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		if segment >= 0 {
+			io.WriteString(w, vulcandRouteSegment(r.URL.Path, segment))
+		}
+	}
+}
+
+func vulcandRouteHandlerParams(params []vulcandRouteParam) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		values := make(map[string]string, len(params))
+		for _, p := range params {
+			values[p.name] = vulcandRouteSegment(r.URL.Path, p.segment)
+		}
+		adapters.WriteParams(w, values)
+	}
+}
+
+func vulcandRouteHandlerFor(method, path string, kind adapters.HandlerKind) http.HandlerFunc {
+	switch kind {
+	case adapters.HandlerWrite:
+		return vulcandRouteHandlerWrite(vulcandRouteParams(path))
+	case adapters.HandlerTest:
+		return func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, r.RequestURI)
+		}
+	case adapters.HandlerParams:
+		return vulcandRouteHandlerParams(vulcandRouteParams(path))
+	case adapters.HandlerMethod:
+		return adapters.MethodHandler(method)
+	}
+	return func(http.ResponseWriter, *http.Request) {}
+}
+
+// vulcandRouteExpr returns the expression of a route, which matches its method
+// and its path. The path is escaped, as vulcand/route matches the escaped
+// paths of the requests.
+func vulcandRouteExpr(method, path string) string {
+	return "Method(" + strconv.Quote(method) + ") && Path(" + strconv.Quote(adapters.EscapePath(path)) + ")"
+}
+
+// loadVulcandRoute adds the routes in a single call, which compiles the
+// expressions once instead of after every route.
+func loadVulcandRoute(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+	handlers := make(map[string]interface{}, len(routes))
+	for _, r := range routes {
+		handlers[vulcandRouteExpr(r.Method, r.Path)] = vulcandRouteHandlerFor(r.Method, r.Path, kind)
+	}
+
+	mux := route.NewMux()
+	if err := mux.InitHandlers(handlers); err != nil {
+		panic(err)
+	}
+	return mux
+}
+
+func loadVulcandRouteSingle(method, path string, kind adapters.HandlerKind) http.Handler {
+	mux := route.NewMux()
+	if err := mux.HandleFunc(vulcandRouteExpr(method, path), vulcandRouteHandlerFor(method, path, kind)); err != nil {
+		panic(err)
+	}
+	return mux
+}
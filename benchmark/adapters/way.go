@@ -0,0 +1,77 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/matryer/way"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	Register(Router{
+		Name:   "Way",
+		Module: "github.com/matryer/way",
+		Capabilities: Capabilities{
+			Dialect:       pathsyntax.Colon,
+			ContextParams: true,
+		},
+		Load:       loadWay,
+		LoadSingle: loadWaySingle,
+	})
+}
+
+// way only provides the params through the request context, so the
+// HandlerWrite and HandlerContextWrite handlers are the same.
+func wayHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, way.Param(r.Context(), "name"))
+}
+
+// wayHandlerParams returns the HandlerParams handler of a route path, as way
+// only provides the params by their names.
+func wayHandlerParams(path string) http.HandlerFunc {
+	names := ParamNames(path, pathsyntax.Colon)
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := make(map[string]string, len(names))
+		for _, name := range names {
+			params[name] = way.Param(r.Context(), name)
+		}
+		WriteParams(w, params)
+	}
+}
+
+func wayHandlerFor(method, path string, kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite, HandlerContextWrite:
+		return wayHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return wayHandlerParams(path)
+	case HandlerMethod:
+		return MethodHandler(method)
+	}
+	return httpHandlerFunc
+}
+
+// loadWay registers the routes in the given order, as way serves a request by
+// the first route matching it.
+func loadWay(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	router := way.NewRouter()
+	for _, route := range routes {
+		router.HandleFunc(route.Method, route.Path, wayHandlerFor(route.Method, route.Path, kind))
+	}
+	return router
+}
+
+func loadWaySingle(method, path string, kind HandlerKind) http.Handler {
+	router := way.NewRouter()
+	router.HandleFunc(method, path, wayHandlerFor(method, path, kind))
+	return router
+}
@@ -0,0 +1,97 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package adapters
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/xujiajun/gorouter"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/internal/pathsyntax"
+)
+
+func init() {
+	// The name is prefixed with the author, as vardius/gorouter is another
+	// router of the same name.
+	Register(Router{
+		Name:   "XujiajunGorouter",
+		Module: "github.com/xujiajun/gorouter",
+		Capabilities: Capabilities{
+			Dialect:           xujiajunGorouterDialect,
+			CatchAll:          true,
+			ConflictingRoutes: true,
+			ContextParams:     true,
+		},
+		Load:       loadXujiajunGorouter,
+		LoadSingle: loadXujiajunGorouterSingle,
+	})
+}
+
+// xujiajunGorouterDialect writes the params with a regexp, as gorouter only
+// matches word characters for /user/:name and digits for /user/:id. The
+// regexp must not contain a slash, since gorouter splits it into the segments
+// of the path like the rest of the route.
+var xujiajunGorouterDialect = pathsyntax.Dialect{
+	Name: "xujiajun-gorouter",
+	Param: func(name string) string {
+		return "{" + name + `:[^\x2f]+}`
+	},
+	CatchAll: pathsyntax.Brace.CatchAll,
+}
+
+// xujiajunGorouterHandlerWrite reads the params from the request context, since
+// this is the only way gorouter provides them.
+func xujiajunGorouterHandlerWrite(w http.ResponseWriter, r *http.Request) {
+	io.WriteString(w, gorouter.GetParam(r, "name"))
+}
+
+func xujiajunGorouterHandlerParams(w http.ResponseWriter, r *http.Request) {
+	WriteParams(w, gorouter.GetAllParams(r))
+}
+
+func xujiajunGorouterHandlerFor(method string, kind HandlerKind) http.HandlerFunc {
+	switch kind {
+	case HandlerWrite, HandlerContextWrite:
+		return xujiajunGorouterHandlerWrite
+	case HandlerTest:
+		return httpHandlerFuncTest
+	case HandlerParams:
+		return xujiajunGorouterHandlerParams
+	case HandlerMethod:
+		return MethodHandler(method)
+	}
+	return httpHandlerFunc
+}
+
+// xujiajunGorouter serves the requests for targets without a path starting
+// with a slash, like * or the authority form, with 400 Bad Request, as
+// gorouter panics for them.
+type xujiajunGorouter struct {
+	*gorouter.Router
+}
+
+func (router xujiajunGorouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if len(r.URL.Path) == 0 || r.URL.Path[0] != '/' {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	router.Router.ServeHTTP(w, r)
+}
+
+func loadXujiajunGorouter(routes []fixtures.Route, kind HandlerKind) http.Handler {
+	router := gorouter.New()
+	for _, route := range routes {
+		router.Handle(route.Method, route.Path, xujiajunGorouterHandlerFor(route.Method, kind))
+	}
+	return xujiajunGorouter{router}
+}
+
+func loadXujiajunGorouterSingle(method, path string, kind HandlerKind) http.Handler {
+	router := gorouter.New()
+	router.Handle(method, path, xujiajunGorouterHandlerFor(method, kind))
+	return xujiajunGorouter{router}
+}
@@ -0,0 +1,163 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// CorpusConfig describes a corpus of requests for a route set.
+type CorpusConfig struct {
+	Requests    int                // number of requests
+	MissRatio   float64            // fraction of requests matching no route
+	ParamLenMin int                // minimum length of param values
+	ParamLenMax int                // maximum length of param values
+	Methods     map[string]float64 // relative weights of the methods, all if empty
+	Seed        int64              // seed of the random generator
+}
+
+// GenerateRequests returns a corpus of requests for the routes. Params of the
+// routes are filled with random values, whose length is uniformly distributed
+// between cfg.ParamLenMin and cfg.ParamLenMax. The same routes and config
+// always result in the same requests.
+func GenerateRequests(routes []Route, cfg CorpusConfig) []Route {
+	if cfg.ParamLenMin < 1 {
+		cfg.ParamLenMin = 1
+	}
+	if cfg.ParamLenMax < cfg.ParamLenMin {
+		cfg.ParamLenMax = cfg.ParamLenMin
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+
+	// group the routes by method and weight the methods
+	byMethod := make(map[string][]Route)
+	for _, route := range routes {
+		byMethod[route.Method] = append(byMethod[route.Method], route)
+	}
+	var methods []string
+	var weights []float64
+	var total float64
+	for method := range byMethod {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	for _, method := range methods {
+		w := 1.0
+		if len(cfg.Methods) > 0 {
+			w = cfg.Methods[method]
+		}
+		weights = append(weights, w)
+		total += w
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	requests := make([]Route, 0, cfg.Requests)
+	for len(requests) < cfg.Requests {
+		// pick the method by its weight
+		x := rng.Float64() * total
+		i := 0
+		for ; i < len(weights)-1 && x >= weights[i]; i++ {
+			x -= weights[i]
+		}
+		candidates := byMethod[methods[i]]
+		route := candidates[rng.Intn(len(candidates))]
+
+		path := fillParams(rng, route.Path, cfg)
+		if rng.Float64() < cfg.MissRatio {
+			var ok bool
+			if path, ok = missPath(rng, path, methods[i], routes, cfg); !ok {
+				continue
+			}
+		}
+		requests = append(requests, Route{route.Method, path})
+	}
+	return requests
+}
+
+// fillParams replaces the params of a path in colon syntax by random values.
+func fillParams(rng *rand.Rand, path string, cfg CorpusConfig) string {
+	if !strings.ContainsAny(path, ":*") {
+		return path
+	}
+
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if len(s) < 2 || (s[0] != ':' && s[0] != '*') {
+			continue
+		}
+		segments[i] = randomValue(rng, cfg)
+		if s[0] == '*' {
+			// catch-all params span multiple segments
+			for n := rng.Intn(3); n > 0; n-- {
+				segments[i] += "/" + randomValue(rng, cfg)
+			}
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+const valueChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+
+func randomValue(rng *rand.Rand, cfg CorpusConfig) string {
+	b := make([]byte, cfg.ParamLenMin+rng.Intn(cfg.ParamLenMax-cfg.ParamLenMin+1))
+	for i := range b {
+		b[i] = valueChars[rng.Intn(len(valueChars))]
+	}
+	return string(b)
+}
+
+// missPath derives a path from path, which matches none of the routes.
+func missPath(rng *rand.Rand, path, method string, routes []Route, cfg CorpusConfig) (string, bool) {
+	for attempts := 0; attempts < 10; attempts++ {
+		// mutate the last segment or append a new one
+		miss := path + "/" + randomValue(rng, cfg)
+		if i := strings.LastIndexByte(path, '/'); attempts%2 == 0 && i+1 < len(path) {
+			miss = path[:i+1] + "~" + randomValue(rng, cfg)
+		}
+
+		matched := false
+		for _, route := range routes {
+			if route.Method == method && Match(route.Path, miss) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return miss, true
+		}
+	}
+	return "", false
+}
+
+// Match reports whether the route path in colon syntax matches the request
+// path. Params match a single non-empty segment, while catch-all params match
+// the rest of the path.
+func Match(route, path string) bool {
+	rs := strings.Split(route, "/")
+	ps := strings.Split(path, "/")
+	for i, s := range rs {
+		if len(s) > 1 && s[0] == '*' {
+			return i < len(ps)
+		}
+		if i >= len(ps) {
+			return false
+		}
+		if len(s) > 1 && s[0] == ':' {
+			if ps[i] == "" {
+				return false
+			}
+			continue
+		}
+		if s != ps[i] {
+			return false
+		}
+	}
+	return len(rs) == len(ps)
+}
@@ -0,0 +1,90 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		route, path string
+		match       bool
+	}{
+		{"/", "/", true},
+		{"/", "/a", false},
+		{"/user/repos", "/user/repos", true},
+		{"/user/repos", "/user/repos/", false},
+		{"/user/:name", "/user/gordon", true},
+		{"/user/:name", "/user/", false},
+		{"/user/:name", "/user/gordon/x", false},
+		{"/src/*filepath", "/src/", true},
+		{"/src/*filepath", "/src/a/b/c", true},
+		{"/src/*filepath", "/src", false},
+		{"/repos/:owner/:repo/events", "/repos/julienschmidt/httprouter/events", true},
+		{"/repos/:owner/:repo/events", "/repos/julienschmidt/events", false},
+	}
+
+	for _, test := range tests {
+		if got := Match(test.route, test.path); got != test.match {
+			t.Errorf("Match(%q, %q): got %v; expected %v", test.route, test.path, got, test.match)
+		}
+	}
+}
+
+func TestGenerateRequests(t *testing.T) {
+	cfg := CorpusConfig{
+		Requests:    1000,
+		MissRatio:   0.2,
+		ParamLenMin: 20,
+		ParamLenMax: 40,
+		Methods:     map[string]float64{"GET": 3, "DELETE": 1},
+		Seed:        42,
+	}
+
+	requests := GenerateRequests(GithubAPI, cfg)
+	if len(requests) != cfg.Requests {
+		t.Fatalf("got %d requests; expected %d", len(requests), cfg.Requests)
+	}
+	if !reflect.DeepEqual(requests, GenerateRequests(GithubAPI, cfg)) {
+		t.Error("same config resulted in different requests")
+	}
+
+	var misses, gets int
+	for _, req := range requests {
+		switch req.Method {
+		case "GET":
+			gets++
+		case "DELETE":
+		default:
+			t.Errorf("unexpected method %s", req.Method)
+		}
+
+		matched := false
+		for _, route := range GithubAPI {
+			if route.Method == req.Method && Match(route.Path, req.Path) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			misses++
+			continue
+		}
+		if strings.Contains(req.Path, ":") {
+			t.Errorf("unfilled param in %s", req.Path)
+		}
+	}
+
+	// allow for some variance of the random distribution
+	if misses < 150 || misses > 250 {
+		t.Errorf("got %d misses; expected about 200", misses)
+	}
+	if gets < 700 || gets > 800 {
+		t.Errorf("got %d GET requests; expected about 750", gets)
+	}
+}
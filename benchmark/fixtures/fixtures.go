@@ -0,0 +1,15 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package fixtures provides the route sets of the benchmarked APIs.
+//
+// The paths of all routes use the colon syntax: /user/:name denotes a named
+// parameter matching a single path segment.
+package fixtures
+
+// Route is a route of an API.
+type Route struct {
+	Method string `json:"method" yaml:"method"`
+	Path   string `json:"path" yaml:"path"`
+}
@@ -0,0 +1,113 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"math/rand"
+	"strconv"
+)
+
+// GeneratorConfig describes the shape of a synthetic route set.
+type GeneratorConfig struct {
+	Routes        int     // number of routes
+	MaxDepth      int     // maximum number of path segments
+	Branching     int     // maximum number of static children of a segment
+	ParamRatio    float64 // probability that a segment has a param child
+	CatchAllRatio float64 // probability that a route ends with a catch-all
+	Seed          int64   // seed of the random generator
+}
+
+// genNode is a path segment of a generated route set.
+// The children of a node are either static or a single param, and a node with
+// a catch-all child has no other children, thus the generated routes don't
+// conflict in any router.
+type genNode struct {
+	segment  string
+	param    bool // the children are a single param
+	catchAll bool // the only child is a catch-all
+	route    bool // the path up to this node is a route
+	children []*genNode
+}
+
+// Generate returns a synthetic route set of GET routes. The same config always
+// results in the same routes. Fewer routes than requested are returned if the
+// shape doesn't allow for more.
+func Generate(cfg GeneratorConfig) []Route {
+	if cfg.MaxDepth < 1 {
+		cfg.MaxDepth = 1
+	}
+	if cfg.Branching < 1 {
+		cfg.Branching = 1
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	root := &genNode{param: rng.Float64() < cfg.ParamRatio}
+
+	var routes []Route
+	for attempts := 0; len(routes) < cfg.Routes && attempts < 10*cfg.Routes; attempts++ {
+		path := ""
+		n := root
+		catchAll := false
+		depth := 1 + rng.Intn(cfg.MaxDepth)
+		for i := 1; i <= depth && !n.catchAll; i++ {
+			if i == depth && len(n.children) == 0 && rng.Float64() < cfg.CatchAllRatio {
+				n.catchAll = true
+				catchAll = true
+				path += "/*filepath"
+				break
+			}
+			n = n.child(rng, cfg, i)
+			path += "/" + n.segment
+		}
+		if !catchAll {
+			if n.catchAll || n.route {
+				continue
+			}
+			n.route = true
+		}
+		routes = append(routes, Route{"GET", path})
+	}
+	return routes
+}
+
+// child returns a random child of n at depth, which is created if required.
+func (n *genNode) child(rng *rand.Rand, cfg GeneratorConfig, depth int) *genNode {
+	if n.param {
+		if len(n.children) == 0 {
+			n.children = append(n.children, &genNode{
+				segment: ":p" + strconv.Itoa(depth),
+				param:   rng.Float64() < cfg.ParamRatio,
+			})
+		}
+		return n.children[0]
+	}
+
+	i := rng.Intn(cfg.Branching)
+	if i < len(n.children) {
+		return n.children[i]
+	}
+	c := &genNode{
+		segment: randomSegment(rng),
+		param:   rng.Float64() < cfg.ParamRatio,
+	}
+	for _, sibling := range n.children {
+		if sibling.segment == c.segment {
+			return sibling
+		}
+	}
+	n.children = append(n.children, c)
+	return c
+}
+
+const segmentChars = "abcdefghijklmnopqrstuvwxyz"
+
+// randomSegment returns a static segment of 2 to 10 lowercase letters.
+func randomSegment(rng *rand.Rand) string {
+	b := make([]byte, 2+rng.Intn(9))
+	for i := range b {
+		b[i] = segmentChars[rng.Intn(len(segmentChars))]
+	}
+	return string(b)
+}
@@ -0,0 +1,71 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	cfg := GeneratorConfig{
+		Routes:        500,
+		MaxDepth:      6,
+		Branching:     8,
+		ParamRatio:    0.3,
+		CatchAllRatio: 0.05,
+		Seed:          42,
+	}
+
+	routes := Generate(cfg)
+	if len(routes) != cfg.Routes {
+		t.Fatalf("got %d routes; expected %d", len(routes), cfg.Routes)
+	}
+	if !reflect.DeepEqual(routes, Generate(cfg)) {
+		t.Error("same config resulted in different routes")
+	}
+
+	seen := make(map[string]bool, len(routes))
+	var params, catchAlls int
+	for _, route := range routes {
+		if seen[route.Path] {
+			t.Errorf("duplicate route %s", route.Path)
+		}
+		seen[route.Path] = true
+
+		segments := strings.Split(route.Path, "/")[1:]
+		if len(segments) > cfg.MaxDepth {
+			t.Errorf("route %s is deeper than %d", route.Path, cfg.MaxDepth)
+		}
+		for i, s := range segments {
+			switch s[0] {
+			case ':':
+				params++
+			case '*':
+				catchAlls++
+				if i != len(segments)-1 {
+					t.Errorf("catch-all is not the last segment of %s", route.Path)
+				}
+			}
+		}
+	}
+	if params == 0 || catchAlls == 0 {
+		t.Errorf("got %d params and %d catch-alls; expected some of both", params, catchAlls)
+	}
+
+	cfg.Seed++
+	if reflect.DeepEqual(routes, Generate(cfg)) {
+		t.Error("different seeds resulted in the same routes")
+	}
+}
+
+func TestGenerateExhausted(t *testing.T) {
+	// only /a-like, /a/b-like and /a/b/c-like paths with a single child each
+	routes := Generate(GeneratorConfig{Routes: 100, MaxDepth: 3, Branching: 1})
+	if len(routes) != 3 {
+		t.Errorf("got %d routes; expected 3: %v", len(routes), routes)
+	}
+}
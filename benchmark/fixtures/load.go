@@ -0,0 +1,57 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LoadFile reads a route set from a JSON or YAML file, depending on the file
+// extension. The file must contain a list of routes, e.g.:
+//
+//	[
+//		{"method": "GET", "path": "/users/:id"},
+//		{"method": "POST", "path": "/users"}
+//	]
+func LoadFile(filename string) ([]Route, error) {
+	var routes []Route
+	if err := unmarshalFile(filename, &routes); err != nil {
+		return nil, err
+	}
+
+	for i, route := range routes {
+		if route.Method == "" || !strings.HasPrefix(route.Path, "/") {
+			return nil, fmt.Errorf("%s: invalid route #%d: %q %q", filename, i, route.Method, route.Path)
+		}
+	}
+	return routes, nil
+}
+
+// unmarshalFile decodes a JSON or YAML file, depending on the file extension.
+func unmarshalFile(filename string, v interface{}) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(filename)); ext {
+	case ".json":
+		err = json.Unmarshal(data, v)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, v)
+	default:
+		return fmt.Errorf("%s: unknown file extension %q", filename, ext)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLoadFile(t *testing.T) {
+	expected := []Route{
+		{"GET", "/users"},
+		{"GET", "/users/:id"},
+		{"POST", "/users"},
+	}
+
+	for _, filename := range []string{"testdata/routes.json", "testdata/routes.yaml"} {
+		routes, err := LoadFile(filename)
+		if err != nil {
+			t.Errorf("%s: %v", filename, err)
+			continue
+		}
+		if !reflect.DeepEqual(routes, expected) {
+			t.Errorf("%s: got %v; expected %v", filename, routes, expected)
+		}
+	}
+
+	if _, err := LoadFile("testdata/routes.txt"); err == nil {
+		t.Error("expected an error for an unknown extension")
+	}
+}
+
+func TestLoadOpenAPI(t *testing.T) {
+	expected := []Route{
+		{"GET", "/users"},
+		{"POST", "/users"},
+		{"GET", "/users/:id"},
+		{"DELETE", "/users/:id"},
+	}
+
+	for _, filename := range []string{"testdata/openapi.json", "testdata/openapi.yaml"} {
+		routes, err := LoadOpenAPI(filename)
+		if err != nil {
+			t.Errorf("%s: %v", filename, err)
+			continue
+		}
+		if !reflect.DeepEqual(routes, expected) {
+			t.Errorf("%s: got %v; expected %v", filename, routes, expected)
+		}
+	}
+
+	// a plain route list is no OpenAPI document
+	if _, err := LoadOpenAPI("testdata/routes.json"); err == nil {
+		t.Error("expected an error for a route list")
+	}
+}
+
+func TestFromPathTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		path     string
+		valid    bool
+	}{
+		{"/", "/", true},
+		{"/users", "/users", true},
+		{"/users/{id}", "/users/:id", true},
+		{"/repos/{owner}/{repo}/events", "/repos/:owner/:repo/events", true},
+		{"/users/{id}/", "/users/:id/", true},
+		{"/users/{id}.json", "", false},
+		{"/users/{}", "", false},
+		{"/users/{{id}}", "", false},
+		{"users/{id}", "", false},
+	}
+
+	for _, test := range tests {
+		path, err := fromPathTemplate(test.template)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: unexpected error %v", test.template, err)
+			continue
+		}
+		if path != test.path {
+			t.Errorf("%s: got %q; expected %q", test.template, path, test.path)
+		}
+	}
+}
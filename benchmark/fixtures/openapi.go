@@ -0,0 +1,72 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// openAPIMethods are the operations of an OpenAPI path item, in the order in
+// which the routes are returned.
+var openAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// LoadOpenAPI reads the routes of an OpenAPI 3 document, given as a JSON or
+// YAML file. Path templates like /users/{id} are converted to the colon syntax.
+// The routes are sorted by path.
+func LoadOpenAPI(filename string) ([]Route, error) {
+	var doc struct {
+		OpenAPI string                            `json:"openapi" yaml:"openapi"`
+		Paths   map[string]map[string]interface{} `json:"paths" yaml:"paths"`
+	}
+	if err := unmarshalFile(filename, &doc); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("%s: not an OpenAPI 3 document", filename)
+	}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var routes []Route
+	for _, template := range paths {
+		path, err := fromPathTemplate(template)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", filename, err)
+		}
+		item := doc.Paths[template]
+		for _, method := range openAPIMethods {
+			if _, ok := item[method]; ok {
+				routes = append(routes, Route{strings.ToUpper(method), path})
+			}
+		}
+	}
+	return routes, nil
+}
+
+// fromPathTemplate converts an OpenAPI path template to the colon syntax.
+// Only templates spanning a whole path segment can be converted.
+func fromPathTemplate(template string) (string, error) {
+	if !strings.HasPrefix(template, "/") {
+		return "", fmt.Errorf("invalid path %q", template)
+	}
+
+	segments := strings.Split(template, "/")
+	for i, s := range segments {
+		if !strings.ContainsAny(s, "{}") {
+			continue
+		}
+		if len(s) < 3 || s[0] != '{' || s[len(s)-1] != '}' || strings.ContainsAny(s[1:len(s)-1], "{}") {
+			return "", fmt.Errorf("unsupported path template %q", template)
+		}
+		segments[i] = ":" + s[1:len(s)-1]
+	}
+	return strings.Join(segments, "/"), nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"sort"
+	"strings"
+)
+
+// Order is an order in which the routes of an API are registered.
+type Order struct {
+	Name string
+	// Apply returns the routes in the order, without modifying them.
+	Apply func(routes []Route) []Route
+}
+
+// Orders are the declared order and adversarial orders of the routes, e.g. for
+// routers whose trees depend on the order of the registration.
+var Orders = []Order{
+	{"Declared", func(routes []Route) []Route {
+		return append([]Route(nil), routes...)
+	}},
+	// static routes first and wildcard routes last
+	{"SpecificFirst", func(routes []Route) []Route {
+		return bySpecificity(routes, true)
+	}},
+	// wildcard routes first and static routes last
+	{"SpecificLast", func(routes []Route) []Route {
+		return bySpecificity(routes, false)
+	}},
+	// a wildcard route before each static route
+	{"Interleaved", interleaved},
+}
+
+// specificity describes how specific a route is: static routes are more
+// specific than routes with params, which are more specific than routes with
+// a catch-all param. Longer routes are more specific than shorter ones.
+type specificity struct {
+	wildcards int
+	catchAll  bool
+	segments  int
+}
+
+// less reports whether s is less specific than t.
+func (s specificity) less(t specificity) bool {
+	if s.catchAll != t.catchAll {
+		return s.catchAll
+	}
+	if s.wildcards != t.wildcards {
+		return s.wildcards > t.wildcards
+	}
+	return s.segments < t.segments
+}
+
+func specificityOf(r Route) specificity {
+	var s specificity
+	for _, segment := range strings.Split(r.Path, "/")[1:] {
+		s.segments++
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			s.wildcards++
+		case strings.HasPrefix(segment, "*"):
+			s.wildcards++
+			s.catchAll = true
+		}
+	}
+	return s
+}
+
+// bySpecificity returns the routes sorted by their specificity, keeping the
+// declared order of equally specific routes.
+func bySpecificity(routes []Route, mostSpecificFirst bool) []Route {
+	sorted := append([]Route(nil), routes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		si, sj := specificityOf(sorted[i]), specificityOf(sorted[j])
+		if mostSpecificFirst {
+			return sj.less(si)
+		}
+		return si.less(sj)
+	})
+	return sorted
+}
+
+// interleaved returns the routes alternating between routes with and without
+// params, starting with a route with params, in their declared order.
+func interleaved(routes []Route) []Route {
+	var wildcards, static []Route
+	for _, r := range routes {
+		if specificityOf(r).wildcards > 0 {
+			wildcards = append(wildcards, r)
+		} else {
+			static = append(static, r)
+		}
+	}
+
+	ordered := make([]Route, 0, len(routes))
+	for i := 0; i < len(wildcards) || i < len(static); i++ {
+		if i < len(wildcards) {
+			ordered = append(ordered, wildcards[i])
+		}
+		if i < len(static) {
+			ordered = append(ordered, static[i])
+		}
+	}
+	return ordered
+}
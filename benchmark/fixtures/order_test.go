@@ -0,0 +1,68 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestOrders(t *testing.T) {
+	routes := []Route{
+		{"GET", "/src/*filepath"},
+		{"GET", "/user/:name"},
+		{"GET", "/users"},
+		{"GET", "/user/:name/repos/:repo"},
+		{"GET", "/user/repos"},
+	}
+	want := map[string][]Route{
+		"Declared": routes,
+		"SpecificFirst": {
+			{"GET", "/user/repos"},
+			{"GET", "/users"},
+			{"GET", "/user/:name"},
+			{"GET", "/user/:name/repos/:repo"},
+			{"GET", "/src/*filepath"},
+		},
+		"SpecificLast": {
+			{"GET", "/src/*filepath"},
+			{"GET", "/user/:name/repos/:repo"},
+			{"GET", "/user/:name"},
+			{"GET", "/users"},
+			{"GET", "/user/repos"},
+		},
+		"Interleaved": {
+			{"GET", "/src/*filepath"},
+			{"GET", "/users"},
+			{"GET", "/user/:name"},
+			{"GET", "/user/repos"},
+			{"GET", "/user/:name/repos/:repo"},
+		},
+	}
+	for _, order := range Orders {
+		if got := order.Apply(routes); !reflect.DeepEqual(got, want[order.Name]) {
+			t.Errorf("%s: %v, want %v", order.Name, got, want[order.Name])
+		}
+	}
+
+	// every order registers every route of the APIs once
+	for _, order := range Orders {
+		got := order.Apply(GithubAPI)
+		if &got[0] == &GithubAPI[0] {
+			t.Errorf("%s: returned the routes instead of a copy", order.Name)
+		}
+		sorted := func(routes []Route) []Route {
+			routes = append([]Route(nil), routes...)
+			sort.Slice(routes, func(i, j int) bool {
+				return routes[i].Method+routes[i].Path < routes[j].Method+routes[j].Path
+			})
+			return routes
+		}
+		if !reflect.DeepEqual(sorted(got), sorted(GithubAPI)) {
+			t.Errorf("%s: routes differ from the API", order.Name)
+		}
+	}
+}
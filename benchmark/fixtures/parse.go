@@ -0,0 +1,51 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+// ParseAPI is a clone of the Parse REST API.
+// https://parse.com/docs/rest#summary
+var ParseAPI = []Route{
+	// Objects
+	{"POST", "/1/classes/:className"},
+	{"GET", "/1/classes/:className/:objectId"},
+	{"PUT", "/1/classes/:className/:objectId"},
+	{"GET", "/1/classes/:className"},
+	{"DELETE", "/1/classes/:className/:objectId"},
+
+	// Users
+	{"POST", "/1/users"},
+	{"GET", "/1/login"},
+	{"GET", "/1/users/:objectId"},
+	{"PUT", "/1/users/:objectId"},
+	{"GET", "/1/users"},
+	{"DELETE", "/1/users/:objectId"},
+	{"POST", "/1/requestPasswordReset"},
+
+	// Roles
+	{"POST", "/1/roles"},
+	{"GET", "/1/roles/:objectId"},
+	{"PUT", "/1/roles/:objectId"},
+	{"GET", "/1/roles"},
+	{"DELETE", "/1/roles/:objectId"},
+
+	// Files
+	{"POST", "/1/files/:fileName"},
+
+	// Analytics
+	{"POST", "/1/events/:eventName"},
+
+	// Push Notifications
+	{"POST", "/1/push"},
+
+	// Installations
+	{"POST", "/1/installations"},
+	{"GET", "/1/installations/:objectId"},
+	{"PUT", "/1/installations/:objectId"},
+	{"GET", "/1/installations"},
+	{"DELETE", "/1/installations/:objectId"},
+
+	// Cloud Functions
+	{"POST", "/1/functions"},
+}
@@ -0,0 +1,102 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Param is a param of a request.
+type Param struct {
+	Name  string
+	Value string
+}
+
+// Sample is a sample request of a route with the params a router must extract
+// from it.
+type Sample struct {
+	Route  Route
+	Path   string  // requested path
+	Params []Param // sorted by name
+}
+
+// NewSample returns the sample request of a route. The value of a param is its
+// name followed by its position, e.g. /user/name1 for /user/:name, while the
+// value of a catch-all param spans two segments, e.g. /src/filepath1/x for
+// /src/*filepath.
+func NewSample(route Route) Sample {
+	sample := Sample{Route: route}
+	segments := strings.Split(route.Path, "/")
+	for i, s := range segments {
+		if len(s) < 2 || (s[0] != ':' && s[0] != '*') {
+			continue
+		}
+		value := s[1:] + strconv.Itoa(len(sample.Params)+1)
+		if s[0] == '*' {
+			value += "/x"
+		}
+		segments[i] = value
+		sample.Params = append(sample.Params, Param{s[1:], value})
+	}
+	sort.Slice(sample.Params, func(i, j int) bool {
+		return sample.Params[i].Name < sample.Params[j].Name
+	})
+	sample.Path = strings.Join(segments, "/")
+	return sample
+}
+
+// Lookup returns the route of the method matching the path and the params of
+// the path. Of conflicting routes, the one with a static segment instead of a
+// param or a param instead of a catch-all param at the first difference wins,
+// as in most routers.
+func Lookup(routes []Route, method, path string) (Route, []Param, bool) {
+	var match Route
+	found := false
+	for _, route := range routes {
+		if route.Method == method && Match(route.Path, path) && (!found || precedes(route.Path, match.Path)) {
+			match, found = route, true
+		}
+	}
+	if !found {
+		return Route{}, nil, false
+	}
+
+	var params []Param
+	ps := strings.Split(path, "/")
+	for i, s := range strings.Split(match.Path, "/") {
+		switch {
+		case len(s) > 1 && s[0] == ':':
+			params = append(params, Param{s[1:], ps[i]})
+		case len(s) > 1 && s[0] == '*':
+			params = append(params, Param{s[1:], strings.Join(ps[i:], "/")})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Name < params[j].Name
+	})
+	return match, params, true
+}
+
+// precedes reports whether the route path a takes precedence over b.
+func precedes(a, b string) bool {
+	rank := func(s string) int {
+		if len(s) > 1 && s[0] == ':' {
+			return 1
+		}
+		if len(s) > 1 && s[0] == '*' {
+			return 2
+		}
+		return 0
+	}
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if ra, rb := rank(as[i]), rank(bs[i]); ra != rb {
+			return ra < rb
+		}
+	}
+	return false
+}
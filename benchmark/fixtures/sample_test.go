@@ -0,0 +1,59 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package fixtures
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewSample(t *testing.T) {
+	tests := []struct {
+		route  string
+		path   string
+		params []Param
+	}{
+		{"/user/repos", "/user/repos", nil},
+		{"/user/:name", "/user/name1", []Param{{"name", "name1"}}},
+		{"/repos/:owner/:repo/events", "/repos/owner1/repo2/events", []Param{{"owner", "owner1"}, {"repo", "repo2"}}},
+		{"/files/:dir/*filepath", "/files/dir1/filepath2/x", []Param{{"dir", "dir1"}, {"filepath", "filepath2/x"}}},
+	}
+
+	for _, test := range tests {
+		sample := NewSample(Route{"GET", test.route})
+		if sample.Path != test.path || !reflect.DeepEqual(sample.Params, test.params) {
+			t.Errorf("NewSample(%q): got %q %v; expected %q %v", test.route, sample.Path, sample.Params, test.path, test.params)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	routes := []Route{
+		{"GET", "/users/:id"},
+		{"GET", "/users/new"},
+		{"GET", "/users/:id/*path"},
+		{"GET", "/users/:id/repos"},
+		{"POST", "/users"},
+	}
+	tests := []struct {
+		method, path string
+		route        string
+		params       []Param
+	}{
+		{"GET", "/users/gordon", "/users/:id", []Param{{"id", "gordon"}}},
+		{"GET", "/users/new", "/users/new", nil},
+		{"GET", "/users/gordon/repos", "/users/:id/repos", []Param{{"id", "gordon"}}},
+		{"GET", "/users/gordon/a/b", "/users/:id/*path", []Param{{"id", "gordon"}, {"path", "a/b"}}},
+		{"POST", "/users", "/users", nil},
+		{"GET", "/users", "", nil},
+	}
+
+	for _, test := range tests {
+		route, params, ok := Lookup(routes, test.method, test.path)
+		if ok != (test.route != "") || route.Path != test.route || !reflect.DeepEqual(params, test.params) {
+			t.Errorf("Lookup(%s %s): got %q %v %v; expected %q %v", test.method, test.path, route.Path, params, ok, test.route, test.params)
+		}
+	}
+}
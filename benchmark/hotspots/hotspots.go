@@ -0,0 +1,178 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package hotspots compares the CPU profiles of two routers, so it tells where
+// one of them spends more time than the other.
+package hotspots
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// harness is the module path of the benchmarks, whose code is shared by the
+// routers.
+const harness = "github.com/julienschmidt/go-http-routing-benchmark"
+
+// Origin is the code a function belongs to.
+type Origin string
+
+const (
+	Stdlib  Origin = "stdlib"  // standard library and runtime, e.g. net/http
+	Harness Origin = "harness" // the benchmarks, e.g. the handlers
+	Router  Origin = "router"  // the router and its dependencies
+)
+
+// OriginOf returns the origin of a function named as in a profile, e.g.
+// "github.com/go-chi/chi.(*node).findRoute" or "runtime.mallocgc".
+func OriginOf(fn string) Origin {
+	if i := strings.IndexByte(fn, '['); i >= 0 {
+		fn = fn[:i] // type arguments of generic functions
+	}
+	pkg := fn
+	if i := strings.IndexByte(fn[strings.LastIndexByte(fn, '/')+1:], '.'); i >= 0 {
+		pkg = fn[:strings.LastIndexByte(fn, '/')+1+i]
+	}
+	switch {
+	case pkg == harness || strings.HasPrefix(pkg, harness+"/"):
+		return Harness
+	case !strings.Contains(strings.SplitN(pkg, "/", 2)[0], "."):
+		// functions without a package, e.g. memeqbody, are part of the runtime
+		return Stdlib
+	}
+	return Router
+}
+
+// Profile is the flat CPU time of the functions of a router in a benchmark.
+type Profile struct {
+	Router  string
+	NsPerOp float64            // of the benchmark
+	Flat    map[string]float64 // sampled ns by function
+}
+
+// ParseTop parses the output of go tool pprof -top -nodecount=0 -unit=ns into
+// the flat time of each function. Inlined functions are merged with their
+// other calls.
+func ParseTop(r io.Reader) (map[string]float64, error) {
+	flat := make(map[string]float64)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		// flat flat% sum% cum cum% name
+		fields := strings.Fields(s.Text())
+		if len(fields) < 6 || !strings.HasSuffix(fields[1], "%") || !strings.HasSuffix(fields[4], "%") {
+			continue
+		}
+		ns, err := strconv.ParseFloat(strings.TrimSuffix(fields[0], "ns"), 64)
+		if err != nil {
+			continue // the header
+		}
+		name := strings.TrimSuffix(strings.Join(fields[5:], " "), " (inline)")
+		flat[name] += ns
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(flat) == 0 {
+		return nil, fmt.Errorf("no samples in the profile")
+	}
+	return flat, nil
+}
+
+// perOp returns the time per op spent in each function, i.e. its share of the
+// samples of the profile applied to the time per op of the benchmark.
+func (p Profile) perOp() map[string]float64 {
+	var total float64
+	for _, ns := range p.Flat {
+		total += ns
+	}
+	perOp := make(map[string]float64, len(p.Flat))
+	for fn, ns := range p.Flat {
+		perOp[fn] = ns / total * p.NsPerOp
+	}
+	return perOp
+}
+
+// Hotspot is the time per op spent in a function by each of two routers.
+type Hotspot struct {
+	Func    string
+	Origin  Origin
+	NsPerOp [2]float64
+}
+
+// Diff is the time the second router spends more in the function.
+func (h Hotspot) Diff() float64 {
+	return h.NsPerOp[1] - h.NsPerOp[0]
+}
+
+// Compare merges the profiles of two routers into hotspots, sorted by the
+// absolute difference of their time per op.
+func Compare(a, b Profile) []Hotspot {
+	byFunc := make(map[string]*Hotspot)
+	for i, p := range []Profile{a, b} {
+		for fn, ns := range p.perOp() {
+			h, ok := byFunc[fn]
+			if !ok {
+				h = &Hotspot{Func: fn, Origin: OriginOf(fn)}
+				byFunc[fn] = h
+			}
+			h.NsPerOp[i] = ns
+		}
+	}
+
+	hotspots := make([]Hotspot, 0, len(byFunc))
+	for _, h := range byFunc {
+		hotspots = append(hotspots, *h)
+	}
+	sort.Slice(hotspots, func(i, j int) bool {
+		di, dj := math.Abs(hotspots[i].Diff()), math.Abs(hotspots[j].Diff())
+		if di != dj {
+			return di > dj
+		}
+		return hotspots[i].Func < hotspots[j].Func
+	})
+	return hotspots
+}
+
+// WriteMarkdown writes a comparison of the profiles of two routers in a
+// benchmark, with a table of the time per op by origin and a table of the top
+// hotspots with the largest differences.
+func WriteMarkdown(w io.Writer, benchmark string, a, b Profile, top int) error {
+	hotspots := Compare(a, b)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(tw, "### %s: %s vs %s\n\n", benchmark, a.Router, b.Router)
+	fmt.Fprintf(tw, "| Origin\t| %s ns/op\t| %s ns/op\t| Diff\t|\n", a.Router, b.Router)
+	fmt.Fprint(tw, "|:--\t|--:\t|--:\t|--:\t|\n")
+	var total Hotspot
+	for _, origin := range []Origin{Stdlib, Harness, Router} {
+		sum := Hotspot{Func: string(origin)}
+		for _, h := range hotspots {
+			if h.Origin == origin {
+				sum.NsPerOp[0] += h.NsPerOp[0]
+				sum.NsPerOp[1] += h.NsPerOp[1]
+			}
+		}
+		total.NsPerOp[0] += sum.NsPerOp[0]
+		total.NsPerOp[1] += sum.NsPerOp[1]
+		fmt.Fprintf(tw, "| %s\t| %.1f\t| %.1f\t| %+.1f\t|\n", origin, sum.NsPerOp[0], sum.NsPerOp[1], sum.Diff())
+	}
+	fmt.Fprintf(tw, "| total\t| %.1f\t| %.1f\t| %+.1f\t|\n", total.NsPerOp[0], total.NsPerOp[1], total.Diff())
+
+	fmt.Fprint(tw, "\n### Hotspots\n\n")
+	fmt.Fprintf(tw, "| Function\t| Origin\t| %s ns/op\t| %s ns/op\t| Diff\t|\n", a.Router, b.Router)
+	fmt.Fprint(tw, "|:--\t|:--\t|--:\t|--:\t|--:\t|\n")
+	for i, h := range hotspots {
+		if i == top {
+			break
+		}
+		fmt.Fprintf(tw, "| %s\t| %s\t| %.1f\t| %.1f\t| %+.1f\t|\n", h.Func, h.Origin, h.NsPerOp[0], h.NsPerOp[1], h.Diff())
+	}
+	return tw.Flush()
+}
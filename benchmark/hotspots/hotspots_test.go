@@ -0,0 +1,109 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package hotspots
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const top = `File: bench.test
+Type: cpu
+Duration: 616.70ms, Total samples = 600000000ns (97.29%)
+Showing nodes accounting for 600000000ns, 100% of 600000000ns total
+      flat  flat%   sum%        cum   cum%
+300000000ns 50.00% 50.00% 300000000ns 50.00%  github.com/go-chi/chi.(*node).findRoute
+100000000ns 16.67% 66.67% 100000000ns 16.67%  github.com/go-chi/chi.nodes.findEdge (inline)
+100000000ns 16.67% 83.33% 100000000ns 16.67%  runtime.mallocgc
+50000000ns  8.33% 91.67% 50000000ns  8.33%  github.com/go-chi/chi.nodes.findEdge
+50000000ns  8.33%   100% 50000000ns  8.33%  memeqbody
+         0     0%   100% 600000000ns   100%  testing.(*B).runN
+`
+
+func TestParseTop(t *testing.T) {
+	flat, err := ParseTop(strings.NewReader(top))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]float64{
+		"github.com/go-chi/chi.(*node).findRoute": 300000000,
+		"github.com/go-chi/chi.nodes.findEdge":    150000000,
+		"runtime.mallocgc":                        100000000,
+		"memeqbody":                               50000000,
+		"testing.(*B).runN":                       0,
+	}
+	if !reflect.DeepEqual(flat, want) {
+		t.Errorf("ParseTop = %v, want %v", flat, want)
+	}
+
+	if _, err := ParseTop(strings.NewReader("File: bench.test\n")); err == nil {
+		t.Error("ParseTop of a profile without samples succeeded")
+	}
+}
+
+func TestOriginOf(t *testing.T) {
+	for fn, want := range map[string]Origin{
+		"runtime.mallocgc":    Stdlib,
+		"memeqbody":           Stdlib,
+		"net/http.Header.Get": Stdlib,
+		"vendor/golang.org/x/net/http2.(*Framer)":                                         Stdlib,
+		"github.com/go-chi/chi.(*node).findRoute":                                         Router,
+		"gopkg.in/macaron.v1.(*Router).ServeHTTP":                                         Router,
+		"github.com/x/y.Map[go.shape.string].Get":                                         Router,
+		"github.com/julienschmidt/httprouter.(*Router).ServeHTTP":                         Router,
+		"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters.Write":     Harness,
+		"github.com/julienschmidt/go-http-routing-benchmark.(*mockResponseWriter).Header": Harness,
+	} {
+		if got := OriginOf(fn); got != want {
+			t.Errorf("OriginOf(%q) = %s, want %s", fn, got, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a := Profile{Router: "A", NsPerOp: 100, Flat: map[string]float64{
+		"runtime.mallocgc": 500,
+		"a.lookup":         500,
+	}}
+	b := Profile{Router: "B", NsPerOp: 300, Flat: map[string]float64{
+		"runtime.mallocgc": 20,
+		"b.lookup":         10,
+	}}
+
+	want := []Hotspot{
+		{"runtime.mallocgc", Stdlib, [2]float64{50, 200}},
+		{"b.lookup", Stdlib, [2]float64{0, 100}},
+		{"a.lookup", Stdlib, [2]float64{50, 0}},
+	}
+	if got := Compare(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare = %v, want %v", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteMarkdown(&buf, "API/Github/All", a, b, 2); err != nil {
+		t.Fatal(err)
+	}
+	wantMarkdown := `### API/Github/All: A vs B
+
+| Origin  | A ns/op | B ns/op | Diff   |
+|:--      |--:      |--:      |--:     |
+| stdlib  | 100.0   | 300.0   | +200.0 |
+| harness | 0.0     | 0.0     | +0.0   |
+| router  | 0.0     | 0.0     | +0.0   |
+| total   | 100.0   | 300.0   | +200.0 |
+
+### Hotspots
+
+| Function         | Origin | A ns/op | B ns/op | Diff   |
+|:--               |:--     |--:      |--:      |--:     |
+| runtime.mallocgc | stdlib | 50.0    | 200.0   | +150.0 |
+| b.lookup         | stdlib | 0.0     | 100.0   | +100.0 |
+`
+	if buf.String() != wantMarkdown {
+		t.Errorf("WriteMarkdown =\n%s\nwant\n%s", buf.String(), wantMarkdown)
+	}
+}
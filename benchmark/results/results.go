@@ -0,0 +1,429 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package results parses the output of the benchmarks and writes reports.
+package results
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Result is the result of a benchmark of a router.
+type Result struct {
+	// Benchmark is the name of the benchmark without the router, e.g.
+	// "Micro/Param" or "API/Github/All".
+	Benchmark string `json:"benchmark"`
+	Router    string `json:"router"`
+
+	N           int     `json:"n"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  int64   `json:"bytes_per_op"`
+	AllocsPerOp int64   `json:"allocs_per_op"`
+
+	// Metrics are the custom metrics of the benchmark by unit, e.g.
+	// "first-ns/op". It is nil if the benchmark reported none.
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+}
+
+// Memory is the memory required by a router for the routes of an API.
+type Memory struct {
+	API    string `json:"api"`
+	Router string `json:"router"`
+	Bytes  int64  `json:"bytes"`
+}
+
+// Tree describes the tree of a router loaded with the routes of an API.
+type Tree struct {
+	API    string  `json:"api"`
+	Router string  `json:"router"`
+	Nodes  int     `json:"nodes"`
+	Depth  int     `json:"depth"`
+	FanOut float64 `json:"fan_out"`
+}
+
+// Caveat is a deviation of a router from the expected routing of the routes of
+// an API, e.g. params it did not extract.
+type Caveat struct {
+	API    string `json:"api"`
+	Router string `json:"router"`
+	Text   string `json:"text"`
+}
+
+// Semantics is the outcome of a probe, i.e. a request which matches no route,
+// e.g. "404", "405+Allow" or "redirect".
+type Semantics struct {
+	Router  string `json:"router"`
+	Probe   string `json:"probe"`
+	Outcome string `json:"outcome"`
+}
+
+// Results are the parsed results of a benchmark run.
+type Results struct {
+	Benchmarks []Result    `json:"benchmarks"`
+	Memory     []Memory    `json:"memory,omitempty"`
+	Trees      []Tree      `json:"trees,omitempty"`
+	Caveats    []Caveat    `json:"caveats,omitempty"`
+	Semantics  []Semantics `json:"semantics,omitempty"`
+}
+
+var (
+	benchLine  = regexp.MustCompile(`^(Benchmark\S+?)(?:-\d+)?\s+(\d+)\s+([\d.]+) ns/op((?:\s+[\d.e+-]+ \S+)*)`)
+	apiLine    = regexp.MustCompile(`^#(\S+) Routes: \d+$`)
+	memoryLine = regexp.MustCompile(`^ +(\S+): (\d+) Bytes$`)
+	caveatLine = regexp.MustCompile(`^ +(\S+): caveat, (.+)$`)
+	treeLine   = regexp.MustCompile(`^ +(\S+): tree, (\d+) nodes, depth (\d+), fan-out ([\d.]+)$`)
+
+	semanticsLine = regexp.MustCompile(`^ +(\S+): semantics, (\S+) (\S+)$`)
+)
+
+// Parser parses the output of the benchmarks line by line, so it can be fed
+// while the benchmarks are running.
+type Parser struct {
+	Results
+
+	api       string // API of the following memory, tree and caveat lines
+	semantics bool   // the following lines are semantics lines
+}
+
+// ParseLine parses a line of output. Lines which are neither benchmark results
+// nor memory measurements, trees, caveats or semantics are ignored.
+func (p *Parser) ParseLine(line string) {
+	if m := apiLine.FindStringSubmatch(line); m != nil {
+		p.api = m[1]
+		return
+	}
+	if line == "#Semantics" {
+		p.semantics = true
+		return
+	}
+	if m := semanticsLine.FindStringSubmatch(line); m != nil && p.semantics {
+		p.Semantics = append(p.Semantics, Semantics{m[1], m[2], m[3]})
+		return
+	}
+	if m := memoryLine.FindStringSubmatch(line); m != nil && p.api != "" {
+		bytes, _ := strconv.ParseInt(m[2], 10, 64)
+		p.Memory = append(p.Memory, Memory{p.api, m[1], bytes})
+		return
+	}
+	if m := treeLine.FindStringSubmatch(line); m != nil && p.api != "" {
+		t := Tree{API: p.api, Router: m[1]}
+		t.Nodes, _ = strconv.Atoi(m[2])
+		t.Depth, _ = strconv.Atoi(m[3])
+		t.FanOut, _ = strconv.ParseFloat(m[4], 64)
+		p.Trees = append(p.Trees, t)
+		return
+	}
+	if m := caveatLine.FindStringSubmatch(line); m != nil && p.api != "" {
+		p.Caveats = append(p.Caveats, Caveat{p.api, m[1], m[2]})
+		return
+	}
+	if strings.TrimSpace(line) == "" {
+		p.api = ""
+		p.semantics = false
+		return
+	}
+
+	m := benchLine.FindStringSubmatch(line)
+	if m == nil {
+		return
+	}
+	r := Result{}
+	r.Benchmark, r.Router = SplitName(m[1])
+	r.N, _ = strconv.Atoi(m[2])
+	r.NsPerOp, _ = strconv.ParseFloat(m[3], 64)
+	metrics := strings.Fields(m[4])
+	for i := 0; i+1 < len(metrics); i += 2 {
+		switch value, unit := metrics[i], metrics[i+1]; unit {
+		case "B/op":
+			r.BytesPerOp, _ = strconv.ParseInt(value, 10, 64)
+		case "allocs/op":
+			r.AllocsPerOp, _ = strconv.ParseInt(value, 10, 64)
+		default:
+			if r.Metrics == nil {
+				r.Metrics = make(map[string]float64)
+			}
+			r.Metrics[unit], _ = strconv.ParseFloat(value, 64)
+		}
+	}
+	p.Benchmarks = append(p.Benchmarks, r)
+}
+
+// Parse parses the output of the benchmarks.
+func Parse(r io.Reader) (Results, error) {
+	var p Parser
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		p.ParseLine(s.Text())
+	}
+	return p.Results, s.Err()
+}
+
+// SplitName splits the name of a benchmark function into the name of the
+// benchmark and the router, e.g. "BenchmarkAPI/Github/Gin/All" into
+// "API/Github/All" and "Gin", or the flat "BenchmarkGin_GithubAll" into
+// "GithubAll" and "Gin".
+func SplitName(name string) (benchmark, router string) {
+	name = strings.TrimPrefix(name, "Benchmark")
+	parts := strings.Split(name, "/")
+	switch {
+	case len(parts) == 4 && parts[0] == "API":
+		return parts[0] + "/" + parts[1] + "/" + parts[3], parts[2]
+	case len(parts) == 3:
+		return parts[0] + "/" + parts[2], parts[1]
+	case len(parts) == 1 && strings.Contains(name, "_"):
+		i := strings.Index(name, "_")
+		return name[i+1:], name[:i]
+	}
+	return name, ""
+}
+
+// sorted returns a copy of the results, whose benchmarks are sorted by
+// benchmark and router, the memory, trees and caveats by API and router and the
+// semantics by router and probe. The results of a router keep their order,
+// e.g. of repeated runs, so the reports do not depend on the order of the
+// routers.
+func sorted(res Results) Results {
+	benchmarks := append([]Result(nil), res.Benchmarks...)
+	sort.SliceStable(benchmarks, func(i, j int) bool {
+		if benchmarks[i].Benchmark != benchmarks[j].Benchmark {
+			return benchmarks[i].Benchmark < benchmarks[j].Benchmark
+		}
+		return benchmarks[i].Router < benchmarks[j].Router
+	})
+
+	memory := append([]Memory(nil), res.Memory...)
+	sort.SliceStable(memory, func(i, j int) bool {
+		if memory[i].API != memory[j].API {
+			return memory[i].API < memory[j].API
+		}
+		return memory[i].Router < memory[j].Router
+	})
+
+	trees := append([]Tree(nil), res.Trees...)
+	sort.SliceStable(trees, func(i, j int) bool {
+		if trees[i].API != trees[j].API {
+			return trees[i].API < trees[j].API
+		}
+		return trees[i].Router < trees[j].Router
+	})
+
+	caveats := append([]Caveat(nil), res.Caveats...)
+	sort.SliceStable(caveats, func(i, j int) bool {
+		if caveats[i].API != caveats[j].API {
+			return caveats[i].API < caveats[j].API
+		}
+		return caveats[i].Router < caveats[j].Router
+	})
+
+	semantics := append([]Semantics(nil), res.Semantics...)
+	sort.SliceStable(semantics, func(i, j int) bool {
+		if semantics[i].Router != semantics[j].Router {
+			return semantics[i].Router < semantics[j].Router
+		}
+		return semantics[i].Probe < semantics[j].Probe
+	})
+
+	return Results{benchmarks, memory, trees, caveats, semantics}
+}
+
+// units returns the sorted units of the custom metrics of the results.
+func units(results []Result) []string {
+	var units []string
+	for _, r := range results {
+		for unit := range r.Metrics {
+			if !contains(units, unit) {
+				units = append(units, unit)
+			}
+		}
+	}
+	sort.Strings(units)
+	return units
+}
+
+// WriteMarkdown writes a report of the results with a table per benchmark, a
+// table of the memory consumption per API, a table of the trees of the routers
+// next to the time for all requests of the API, a table of the caveats and a
+// table of the outcomes of the probes per router. The tables of benchmarks with
+// custom metrics have a column per metric. The rows, columns and tables are
+// sorted as by WriteJSON, with the columns of the metrics and the probes
+// sorted by name.
+func WriteMarkdown(w io.Writer, res Results) error {
+	res = sorted(res)
+
+	var benchmarks []string
+	byBenchmark := make(map[string][]Result)
+	for _, r := range res.Benchmarks {
+		if _, ok := byBenchmark[r.Benchmark]; !ok {
+			benchmarks = append(benchmarks, r.Benchmark)
+		}
+		byBenchmark[r.Benchmark] = append(byBenchmark[r.Benchmark], r)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+	for _, b := range benchmarks {
+		results := byBenchmark[b]
+		units := units(results)
+
+		fmt.Fprintf(tw, "### %s\n\n", b)
+		fmt.Fprint(tw, "| Router\t| ns/op\t|")
+		for _, unit := range units {
+			fmt.Fprintf(tw, " %s\t|", unit)
+		}
+		fmt.Fprint(tw, " B/op\t| allocs/op\t|\n")
+		fmt.Fprint(tw, "|:--\t|--:\t|")
+		for range units {
+			fmt.Fprint(tw, "--:\t|")
+		}
+		fmt.Fprint(tw, "--:\t|--:\t|\n")
+		for _, r := range results {
+			fmt.Fprintf(tw, "| %s\t| %.1f\t|", r.Router, r.NsPerOp)
+			for _, unit := range units {
+				fmt.Fprintf(tw, " %.1f\t|", r.Metrics[unit])
+			}
+			fmt.Fprintf(tw, " %d\t| %d\t|\n", r.BytesPerOp, r.AllocsPerOp)
+		}
+		fmt.Fprint(tw, "\n")
+	}
+
+	if len(res.Memory) > 0 {
+		fmt.Fprint(tw, "### Memory consumption\n\n")
+		fmt.Fprint(tw, "| API\t| Router\t| Bytes\t|\n")
+		fmt.Fprint(tw, "|:--\t|:--\t|--:\t|\n")
+		for _, m := range res.Memory {
+			fmt.Fprintf(tw, "| %s\t| %s\t| %d\t|\n", m.API, m.Router, m.Bytes)
+		}
+	}
+
+	if len(res.Trees) > 0 {
+		all := make(map[[2]string]string)
+		for _, r := range res.Benchmarks {
+			if strings.HasPrefix(r.Benchmark, "API/") && strings.HasSuffix(r.Benchmark, "/All") {
+				api := strings.TrimSuffix(strings.TrimPrefix(r.Benchmark, "API/"), "/All")
+				all[[2]string{api, r.Router}] = fmt.Sprintf("%.1f", r.NsPerOp)
+			}
+		}
+
+		if len(res.Memory) > 0 {
+			fmt.Fprint(tw, "\n")
+		}
+		fmt.Fprint(tw, "### Trees\n\n")
+		fmt.Fprint(tw, "| API\t| Router\t| Nodes\t| Depth\t| Fan-out\t| All ns/op\t|\n")
+		fmt.Fprint(tw, "|:--\t|:--\t|--:\t|--:\t|--:\t|--:\t|\n")
+		for _, t := range res.Trees {
+			fmt.Fprintf(tw, "| %s\t| %s\t| %d\t| %d\t| %.2f\t| %s\t|\n", t.API, t.Router, t.Nodes, t.Depth, t.FanOut, all[[2]string{t.API, t.Router}])
+		}
+	}
+
+	if len(res.Caveats) > 0 {
+		if len(res.Memory) > 0 || len(res.Trees) > 0 {
+			fmt.Fprint(tw, "\n")
+		}
+		fmt.Fprint(tw, "### Caveats\n\n")
+		fmt.Fprint(tw, "| API\t| Router\t| Caveat\t|\n")
+		fmt.Fprint(tw, "|:--\t|:--\t|:--\t|\n")
+		for _, c := range res.Caveats {
+			fmt.Fprintf(tw, "| %s\t| %s\t| %s\t|\n", c.API, c.Router, c.Text)
+		}
+	}
+
+	if len(res.Semantics) > 0 {
+		var probes, routers []string
+		outcomes := make(map[[2]string]string)
+		for _, s := range res.Semantics {
+			if !contains(probes, s.Probe) {
+				probes = append(probes, s.Probe)
+			}
+			if !contains(routers, s.Router) {
+				routers = append(routers, s.Router)
+			}
+			outcomes[[2]string{s.Router, s.Probe}] = s.Outcome
+		}
+		sort.Strings(probes)
+
+		if len(res.Memory) > 0 || len(res.Trees) > 0 || len(res.Caveats) > 0 {
+			fmt.Fprint(tw, "\n")
+		}
+		fmt.Fprint(tw, "### Semantics\n\n")
+		fmt.Fprint(tw, "| Router\t|")
+		for _, probe := range probes {
+			fmt.Fprintf(tw, " %s\t|", probe)
+		}
+		fmt.Fprint(tw, "\n|:--\t|")
+		for range probes {
+			fmt.Fprint(tw, ":--\t|")
+		}
+		fmt.Fprint(tw, "\n")
+		for _, router := range routers {
+			fmt.Fprintf(tw, "| %s\t|", router)
+			for _, probe := range probes {
+				fmt.Fprintf(tw, " %s\t|", outcomes[[2]string{router, probe}])
+			}
+			fmt.Fprint(tw, "\n")
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteJSON writes the results as an indented JSON object, with the results
+// sorted by benchmark, API, router and probe, and the metrics sorted by unit.
+func WriteJSON(w io.Writer, res Results) error {
+	data, err := json.MarshalIndent(sorted(res), "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// WriteCSV writes the results of the benchmarks as CSV with a header line and a
+// line per result, sorted as by WriteJSON. The metrics of all benchmarks have
+// a column each, sorted by unit, which is empty for the benchmarks without
+// the metric. The memory, trees, caveats and semantics are only written by
+// WriteMarkdown and WriteJSON.
+func WriteCSV(w io.Writer, res Results) error {
+	res = sorted(res)
+	units := units(res.Benchmarks)
+
+	cw := csv.NewWriter(w)
+	header := append([]string{"benchmark", "router", "n", "ns/op"}, units...)
+	cw.Write(append(header, "B/op", "allocs/op"))
+	for _, r := range res.Benchmarks {
+		record := []string{
+			r.Benchmark,
+			r.Router,
+			strconv.Itoa(r.N),
+			strconv.FormatFloat(r.NsPerOp, 'f', -1, 64),
+		}
+		for _, unit := range units {
+			value, ok := r.Metrics[unit]
+			if !ok {
+				record = append(record, "")
+				continue
+			}
+			record = append(record, strconv.FormatFloat(value, 'f', -1, 64))
+		}
+		record = append(record, strconv.FormatInt(r.BytesPerOp, 10), strconv.FormatInt(r.AllocsPerOp, 10))
+		cw.Write(record)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
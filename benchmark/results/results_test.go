@@ -0,0 +1,344 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package results
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const output = `#Github Routes: 203
+   Chi: 94576 Bytes
+   Chi: tree, 246 nodes, depth 16, fan-out 1.60
+   HttpRouter: 42600 Bytes
+   Macaron: caveat, GET /user/name1: missing param name
+
+#Semantics
+   Chi: semantics, NotFound 404
+   Chi: semantics, MethodNotAllowed 405
+   HttpRouter: semantics, NotFound 404
+   HttpRouter: semantics, MethodNotAllowed 405+Allow
+
+goos: linux
+goarch: amd64
+pkg: github.com/julienschmidt/go-http-routing-benchmark
+BenchmarkAPI/Github/HttpRouter/All   	   38241	     31412 ns/op	   13792 B/op	     167 allocs/op
+BenchmarkMicro/Chi/Param-8           	 2331032	       503.2 ns/op	     372 B/op	       2 allocs/op
+BenchmarkStatic/HttpServeMux/All     	   36452	     33441 ns/op	       0 B/op	       0 allocs/op
+BenchmarkGin_GithubAll               	   41000	     29000 ns/op
+BenchmarkFirstMatch/Chi/Github-8     	    4712	    251230 ns/op	      1520 first-ns/op	       980.5 next-ns/op	   74709 B/op	     406 allocs/op
+PASS
+ok  	github.com/julienschmidt/go-http-routing-benchmark	5.123s
+`
+
+func TestParse(t *testing.T) {
+	res, err := Parse(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantMemory := []Memory{
+		{"Github", "Chi", 94576},
+		{"Github", "HttpRouter", 42600},
+	}
+	if !reflect.DeepEqual(res.Memory, wantMemory) {
+		t.Errorf("Memory = %v, want %v", res.Memory, wantMemory)
+	}
+
+	wantTrees := []Tree{{"Github", "Chi", 246, 16, 1.6}}
+	if !reflect.DeepEqual(res.Trees, wantTrees) {
+		t.Errorf("Trees = %v, want %v", res.Trees, wantTrees)
+	}
+
+	wantCaveats := []Caveat{{"Github", "Macaron", "GET /user/name1: missing param name"}}
+	if !reflect.DeepEqual(res.Caveats, wantCaveats) {
+		t.Errorf("Caveats = %v, want %v", res.Caveats, wantCaveats)
+	}
+
+	wantSemantics := []Semantics{
+		{"Chi", "NotFound", "404"},
+		{"Chi", "MethodNotAllowed", "405"},
+		{"HttpRouter", "NotFound", "404"},
+		{"HttpRouter", "MethodNotAllowed", "405+Allow"},
+	}
+	if !reflect.DeepEqual(res.Semantics, wantSemantics) {
+		t.Errorf("Semantics = %v, want %v", res.Semantics, wantSemantics)
+	}
+
+	wantBenchmarks := []Result{
+		{"API/Github/All", "HttpRouter", 38241, 31412, 13792, 167, nil},
+		{"Micro/Param", "Chi", 2331032, 503.2, 372, 2, nil},
+		{"Static/All", "HttpServeMux", 36452, 33441, 0, 0, nil},
+		{"GithubAll", "Gin", 41000, 29000, 0, 0, nil},
+		{"FirstMatch/Github", "Chi", 4712, 251230, 74709, 406, map[string]float64{"first-ns/op": 1520, "next-ns/op": 980.5}},
+	}
+	if !reflect.DeepEqual(res.Benchmarks, wantBenchmarks) {
+		t.Errorf("Benchmarks = %v, want %v", res.Benchmarks, wantBenchmarks)
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	res := Results{
+		Benchmarks: []Result{
+			{"Micro/Param", "HttpRouter", 100, 72.8, 32, 1, nil},
+			{"Micro/Param", "Chi", 100, 503.2, 372, 2, nil},
+			{"API/Github/All", "Chi", 100, 113412, 61714, 406, nil},
+			{"FirstMatch/Github", "Chi", 100, 251230, 74709, 406, map[string]float64{"first-ns/op": 1520, "next-ns/op": 980.5}},
+		},
+		Memory: []Memory{{"Github", "Chi", 94576}},
+		Trees: []Tree{
+			{"Github", "HttpRouter", 406, 14, 1.5826},
+			{"Github", "Chi", 246, 16, 1.6013},
+		},
+		Caveats: []Caveat{{"Github", "Macaron", "GET /user/name1: missing param name"}},
+		Semantics: []Semantics{
+			{"HttpRouter", "NotFound", "404"},
+			{"HttpRouter", "MethodNotAllowed", "405+Allow"},
+			{"Chi", "NotFound", "404"},
+			{"Chi", "MethodNotAllowed", "405"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteMarkdown(&buf, res); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `### API/Github/All
+
+| Router | ns/op    | B/op  | allocs/op |
+|:--     |--:       |--:    |--:        |
+| Chi    | 113412.0 | 61714 | 406       |
+
+### FirstMatch/Github
+
+| Router | ns/op    | first-ns/op | next-ns/op | B/op  | allocs/op |
+|:--     |--:       |--:          |--:         |--:    |--:        |
+| Chi    | 251230.0 | 1520.0      | 980.5      | 74709 | 406       |
+
+### Micro/Param
+
+| Router     | ns/op | B/op | allocs/op |
+|:--         |--:    |--:   |--:        |
+| Chi        | 503.2 | 372  | 2         |
+| HttpRouter | 72.8  | 32   | 1         |
+
+### Memory consumption
+
+| API    | Router | Bytes |
+|:--     |:--     |--:    |
+| Github | Chi    | 94576 |
+
+### Trees
+
+| API    | Router     | Nodes | Depth | Fan-out | All ns/op |
+|:--     |:--         |--:    |--:    |--:      |--:        |
+| Github | Chi        | 246   | 16    | 1.60    | 113412.0  |
+| Github | HttpRouter | 406   | 14    | 1.58    |           |
+
+### Caveats
+
+| API    | Router  | Caveat                              |
+|:--     |:--      |:--                                  |
+| Github | Macaron | GET /user/name1: missing param name |
+
+### Semantics
+
+| Router     | MethodNotAllowed | NotFound |
+|:--         |:--               |:--       |
+| Chi        | 405              | 404      |
+| HttpRouter | 405+Allow        | 404      |
+`
+	if buf.String() != want {
+		t.Errorf("WriteMarkdown:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	res := Results{
+		Benchmarks: []Result{
+			{"Micro/Param", "HttpRouter", 100, 72.8, 32, 1, nil},
+			{"Micro/Param", "Chi", 100, 503.2, 372, 2, nil},
+			{"FirstMatch/Github", "Chi", 100, 251230, 74709, 406, map[string]float64{"next-ns/op": 980.5, "first-ns/op": 1520}},
+		},
+		Memory: []Memory{{"Github", "Chi", 94576}},
+	}
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, res); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `benchmark,router,n,ns/op,first-ns/op,next-ns/op,B/op,allocs/op
+FirstMatch/Github,Chi,100,251230,1520,980.5,74709,406
+Micro/Param,Chi,100,503.2,,,372,2
+Micro/Param,HttpRouter,100,72.8,,,32,1
+`
+	if buf.String() != want {
+		t.Errorf("WriteCSV:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	res := Results{
+		Benchmarks: []Result{
+			{"Micro/Param", "HttpRouter", 100, 72.8, 32, 1, nil},
+			{"FirstMatch/Github", "Chi", 100, 251230, 74709, 406, map[string]float64{"next-ns/op": 980.5, "first-ns/op": 1520}},
+		},
+		Semantics: []Semantics{
+			{"HttpRouter", "NotFound", "404"},
+			{"Chi", "NotFound", "404"},
+			{"Chi", "MethodNotAllowed", "405"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, res); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+	"benchmarks": [
+		{
+			"benchmark": "FirstMatch/Github",
+			"router": "Chi",
+			"n": 100,
+			"ns_per_op": 251230,
+			"bytes_per_op": 74709,
+			"allocs_per_op": 406,
+			"metrics": {
+				"first-ns/op": 1520,
+				"next-ns/op": 980.5
+			}
+		},
+		{
+			"benchmark": "Micro/Param",
+			"router": "HttpRouter",
+			"n": 100,
+			"ns_per_op": 72.8,
+			"bytes_per_op": 32,
+			"allocs_per_op": 1
+		}
+	],
+	"semantics": [
+		{
+			"router": "Chi",
+			"probe": "MethodNotAllowed",
+			"outcome": "405"
+		},
+		{
+			"router": "Chi",
+			"probe": "NotFound",
+			"outcome": "404"
+		},
+		{
+			"router": "HttpRouter",
+			"probe": "NotFound",
+			"outcome": "404"
+		}
+	]
+}
+`
+	if buf.String() != want {
+		t.Errorf("WriteJSON:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+// routerOutputs are the outputs of benchmark runs of single routers, e.g. of
+// the run mode with -per-router, with a repeated benchmark as with -count=2,
+// with the scenarios, metrics and probes in different orders.
+var routerOutputs = []string{
+	`#Github Routes: 203
+   Chi: 94576 Bytes
+
+#Semantics
+   Chi: semantics, NotFound 404
+   Chi: semantics, MethodNotAllowed 405
+
+BenchmarkAPI/Github/Chi/All   	   10000	    113412 ns/op	   61714 B/op	     406 allocs/op
+BenchmarkAPI/Github/Chi/All   	   10000	    112907 ns/op	   61714 B/op	     406 allocs/op
+BenchmarkMicro/Chi/Param      	 2331032	       503.2 ns/op	     372 B/op	       2 allocs/op
+BenchmarkFirstMatch/Chi/Github	    4712	    251230 ns/op	      1520 first-ns/op	       980.5 next-ns/op	   74709 B/op	     406 allocs/op
+`,
+	`#Github Routes: 203
+   HttpRouter: 42600 Bytes
+
+#Semantics
+   HttpRouter: semantics, MethodNotAllowed 405+Allow
+   HttpRouter: semantics, NotFound 404
+
+BenchmarkMicro/HttpRouter/Param      	16455098	        72.8 ns/op	      32 B/op	       1 allocs/op
+BenchmarkFirstMatch/HttpRouter/Github	   21834	     54321 ns/op	       640.5 next-ns/op	      2210 first-ns/op	   35120 B/op	     170 allocs/op
+BenchmarkAPI/Github/HttpRouter/All   	   38241	     31412 ns/op	   13792 B/op	     167 allocs/op
+`,
+	`#Github Routes: 203
+   Macaron: 149404 Bytes
+   Macaron: caveat, GET /user/name1: missing param name
+   Macaron: caveat, GET /users/name1/repos: missing param name
+
+#Semantics
+   Macaron: semantics, NotFound 404
+   Macaron: semantics, MethodNotAllowed 404
+
+BenchmarkAPI/Github/Macaron/All   	    3946	    301210 ns/op	  147424 B/op	    2201 allocs/op
+`,
+}
+
+// writers are the writers of the reports by format.
+var writers = map[string]func(io.Writer, Results) error{
+	"Markdown": WriteMarkdown,
+	"JSON":     WriteJSON,
+	"CSV":      WriteCSV,
+}
+
+// TestWriteDeterministic checks that the reports in every format do not depend
+// on the order of the outputs of the routers, so that reports of the same
+// results differ in no byte, e.g. in version control.
+func TestWriteDeterministic(t *testing.T) {
+	for format, write := range writers {
+		var want string
+		permute(len(routerOutputs), func(order []int) {
+			var output strings.Builder
+			for _, i := range order {
+				output.WriteString(routerOutputs[i])
+			}
+			res, err := Parse(strings.NewReader(output.String()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			if err := write(&buf, res); err != nil {
+				t.Fatal(err)
+			}
+
+			if want == "" {
+				want = buf.String()
+			} else if buf.String() != want {
+				t.Errorf("%s of the outputs in order %v:\n%s\nwant:\n%s", format, order, buf.String(), want)
+			}
+		})
+	}
+}
+
+// permute calls f with every permutation of 0, ..., n-1.
+func permute(n int, f func([]int)) {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	var generate func(k int)
+	generate = func(k int) {
+		if k == n {
+			f(order)
+			return
+		}
+		for i := k; i < n; i++ {
+			order[k], order[i] = order[i], order[k]
+			generate(k + 1)
+			order[k], order[i] = order[i], order[k]
+		}
+	}
+	generate(0)
+}
@@ -0,0 +1,99 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package scenarios holds the registry of benchmarked workloads.
+//
+// Every registered scenario is benchmarked against all routers. Custom
+// scenarios, e.g. the shape of a company-internal API, can be added without
+// changing the benchmark harness, either by a <name>_test.go file in the
+// harness or by a separate package imported for its side effects, which call
+// Register in an init function:
+//
+//	func init() {
+//		scenarios.Register(scenarios.New("MyAPI", myRoutes, adapters.HandlerEmpty,
+//			scenarios.Get("Param", "/users/gordon"),
+//			scenarios.Sequence("All", myRoutes),
+//		))
+//	}
+package scenarios
+
+import (
+	"sort"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// Scenario is a benchmarked workload.
+type Scenario interface {
+	// Name identifies the scenario in benchmark names.
+	Name() string
+
+	// Routes returns the routes loaded into the routers, in colon syntax.
+	Routes() []fixtures.Route
+
+	// Requests returns the benchmarked requests.
+	Requests() []Request
+
+	// HandlerKind selects the handlers registered for the routes.
+	HandlerKind() adapters.HandlerKind
+}
+
+// Request is a benchmarked request. All of its routes are requested in order
+// in a single benchmark operation.
+type Request struct {
+	Name   string
+	Routes []fixtures.Route
+}
+
+// Get returns a Request for a single GET request of path.
+func Get(name, path string) Request {
+	return Request{name, []fixtures.Route{{Method: "GET", Path: path}}}
+}
+
+// Sequence returns a Request requesting each of the routes once.
+func Sequence(name string, routes []fixtures.Route) Request {
+	return Request{name, routes}
+}
+
+type scenario struct {
+	name     string
+	routes   []fixtures.Route
+	requests []Request
+	kind     adapters.HandlerKind
+}
+
+// New returns a Scenario loading routes with handlers of the given kind.
+func New(name string, routes []fixtures.Route, kind adapters.HandlerKind, requests ...Request) Scenario {
+	return &scenario{name, routes, requests, kind}
+}
+
+func (s *scenario) Name() string                      { return s.name }
+func (s *scenario) Routes() []fixtures.Route          { return s.routes }
+func (s *scenario) Requests() []Request               { return s.requests }
+func (s *scenario) HandlerKind() adapters.HandlerKind { return s.kind }
+
+// registry of all scenarios, sorted by name
+var registry []Scenario
+
+// Register adds a scenario to the registry.
+// It panics if a scenario with the same name is already registered.
+func Register(s Scenario) {
+	name := s.Name()
+	i := sort.Search(len(registry), func(i int) bool {
+		return registry[i].Name() >= name
+	})
+	if i < len(registry) && registry[i].Name() == name {
+		panic("scenario registered twice: " + name)
+	}
+
+	registry = append(registry, nil)
+	copy(registry[i+1:], registry[i:])
+	registry[i] = s
+}
+
+// All returns all registered scenarios, sorted by name.
+func All() []Scenario {
+	return append([]Scenario(nil), registry...)
+}
@@ -0,0 +1,183 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"math/bits"
+	"net/http"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+const (
+	// churnQuietRequests is the number of requests timed before the background
+	// load of the Churn benchmarks starts.
+	churnQuietRequests = 1 << 16
+
+	// churnGoroutines is the number of short-lived goroutines started per
+	// millisecond by the background load of the Churn benchmarks.
+	churnGoroutines = 16
+)
+
+// BenchmarkChurn requests the routes of every scenario, while goroutines are
+// started and allocate in the background, like in a busy server, named
+// BenchmarkChurn/<Router>/<Scenario>. Every request is timed on its own, first
+// on a quiet process and then during the ops under the background load. The
+// 99th percentiles of the requests are reported as quiet-p99-ns and
+// churn-p99-ns, so the difference is the shift of the tail latency of the
+// router under load. The B/op and allocs/op include the background load.
+//
+// Unlike the other benchmarks, these run with GOMAXPROCS of the number of CPUs,
+// but at least 2, so that the background load runs in parallel to the requests
+// instead of preempting them.
+func BenchmarkChurn(b *testing.B) {
+	for _, router := range routers {
+		b.Run(router.Name, func(b *testing.B) {
+			for _, s := range selectedScenarios() {
+				if router.Capabilities.Check(s.Routes()) != nil || !router.Capabilities.Supports(s.HandlerKind()) {
+					continue
+				}
+				b.Run(s.Name(), func(b *testing.B) {
+					benchChurn(b, router.Load(s.Routes(), s.HandlerKind()), s.Routes())
+				})
+			}
+		})
+	}
+}
+
+func benchChurn(b *testing.B, router http.Handler, routes []fixtures.Route) {
+	procs := runtime.NumCPU()
+	if procs < 2 {
+		procs = 2
+	}
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+
+	w := new(mockResponseWriter)
+	src := newRequestSource(routes)
+	var quiet, churn latencyHistogram
+
+	serve := func(h *latencyHistogram, j int) {
+		r := src.request(j)
+		start := time.Now()
+		router.ServeHTTP(w, r)
+		h.record(time.Since(start))
+		src.release(r)
+	}
+
+	for i := 0; i < churnQuietRequests; i++ {
+		serve(&quiet, i%len(routes))
+	}
+
+	stop := make(chan struct{})
+	done := churnInBackground(stop)
+	b.ReportAllocs()
+	for loop := benchLoop(b); loop(); {
+		for j := range routes {
+			serve(&churn, j)
+		}
+	}
+	close(stop)
+	<-done
+
+	b.ReportMetric(quiet.quantile(0.99), "quiet-p99-ns")
+	b.ReportMetric(churn.quantile(0.99), "churn-p99-ns")
+}
+
+// churnInBackground starts churnGoroutines goroutines per millisecond until
+// stop is closed, each allocating a buffer of 1KB, while another goroutine
+// allocates as in the GCPressure benchmarks. The returned channel is closed,
+// once all goroutines returned.
+func churnInBackground(stop <-chan struct{}) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		allocated := allocateInBackground(stop)
+		var wg sync.WaitGroup
+		for {
+			select {
+			case <-stop:
+				wg.Wait()
+				<-allocated
+				return
+			default:
+			}
+			bufs := make([][]byte, churnGoroutines)
+			wg.Add(churnGoroutines)
+			for i := range bufs {
+				go func(i int) {
+					defer wg.Done()
+					bufs[i] = make([]byte, 1024)
+				}(i)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	return done
+}
+
+// latencyHistogram counts latencies in buckets with a relative width of 1/16,
+// so recording a latency does not allocate.
+type latencyHistogram struct {
+	counts [64 * 16]int64
+	total  int64
+}
+
+// bucket returns the bucket of a latency of ns nanoseconds, which is indexed by
+// the position of its highest bit and the 4 bits below.
+func (h *latencyHistogram) bucket(ns uint64) int {
+	n := bits.Len64(ns)
+	if n <= 5 {
+		return int(ns) // exact for latencies below 32ns
+	}
+	return n*16 + int(ns>>uint(n-5))&15
+}
+
+// lower returns the lowest latency of a bucket in nanoseconds.
+func (h *latencyHistogram) lower(bucket int) uint64 {
+	if bucket < 32 {
+		return uint64(bucket)
+	}
+	n := bucket / 16
+	return (16 + uint64(bucket%16)) << uint(n-5)
+}
+
+func (h *latencyHistogram) record(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	h.counts[h.bucket(uint64(d))]++
+	h.total++
+}
+
+// quantile returns the lowest latency in nanoseconds of the bucket of the q
+// quantile of the recorded latencies.
+func (h *latencyHistogram) quantile(q float64) float64 {
+	rank := int64(q * float64(h.total))
+	for bucket, count := range h.counts {
+		rank -= count
+		if rank < 0 {
+			return float64(h.lower(bucket))
+		}
+	}
+	return 0
+}
+
+func TestLatencyHistogram(t *testing.T) {
+	var h latencyHistogram
+	for ns := 1; ns <= 1000; ns++ {
+		h.record(time.Duration(ns))
+	}
+	// the bucket of 990ns starts at 960ns
+	if q := h.quantile(0.99); q != 960 {
+		t.Errorf("quantile(0.99) = %v, want 960", q)
+	}
+	if q := h.quantile(0.01); q != 11 {
+		t.Errorf("quantile(0.01) = %v, want 11", q)
+	}
+}
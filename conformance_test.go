@@ -0,0 +1,118 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// maxCaveats is the maximum number of caveats printed per router and API.
+const maxCaveats = 5
+
+func TestConformance(t *testing.T) {
+	for _, router := range routers {
+		for _, s := range selectedScenarios() {
+			if router.Capabilities.Check(s.Routes()) != nil {
+				continue // logged by testRouter
+			}
+			for _, caveat := range conformance(router, s.Routes()) {
+				t.Errorf("%s in scenario %s: %s", router.Name, s.Name(), caveat)
+			}
+		}
+	}
+}
+
+// conformance requests the sample request of every route from the router,
+// loaded with the routes, and returns the caveats, i.e. the requests for which
+// the router did not extract the params of the sample.
+// Routers may name the params differently, as their dialect might rename them,
+// e.g. chi knows /src/*filepath as /src/*. The values of catch-all params may
+// start with a slash, as in httprouter.
+func conformance(router adapters.Router, routes []fixtures.Route) []string {
+	h := router.Load(routes, adapters.HandlerParams)
+
+	var caveats []string
+	for _, route := range routes {
+		sample := fixtures.NewSample(route)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(route.Method, sample.Path, nil))
+		if w.Code != 200 {
+			caveats = append(caveats, fmt.Sprintf("%s %s: status %d", route.Method, sample.Path, w.Code))
+			continue
+		}
+		if diffs := diffParams(sample, w.Body.String()); len(diffs) > 0 {
+			caveats = append(caveats, fmt.Sprintf("%s %s: %s", route.Method, sample.Path, strings.Join(diffs, ", ")))
+		}
+	}
+	return caveats
+}
+
+// printCaveats prints up to maxCaveats caveats of a router.
+func printCaveats(router string, caveats []string) {
+	for i, caveat := range caveats {
+		if i == maxCaveats {
+			println("   "+router+": caveat,", len(caveats)-i, "more requests")
+			break
+		}
+		println("   "+router+": caveat,", caveat)
+	}
+}
+
+// diffParams compares the params of the sample with the params written by a
+// HandlerParams handler.
+func diffParams(sample fixtures.Sample, body string) []string {
+	got := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\n"), "\n") {
+		if i := strings.IndexByte(line, '='); i >= 0 {
+			got[line[:i]] = line[i+1:]
+		}
+	}
+	expected := make(map[string]bool, len(sample.Params))
+	for _, p := range sample.Params {
+		expected[p.Name] = true
+	}
+
+	var diffs []string
+	for _, p := range sample.Params {
+		catchAll := strings.HasSuffix(sample.Route.Path, "/*"+p.Name)
+		equal := func(value string) bool {
+			return value == p.Value || catchAll && value == "/"+p.Value
+		}
+
+		name := p.Name
+		if _, ok := got[name]; !ok {
+			// the dialect of the router might have renamed the param
+			name = ""
+			for n, v := range got {
+				if !expected[n] && equal(v) {
+					name = n
+					break
+				}
+			}
+			if name == "" {
+				diffs = append(diffs, "missing param "+p.Name)
+				continue
+			}
+		}
+		if v := got[name]; !equal(v) {
+			diffs = append(diffs, fmt.Sprintf("param %s is %q, expected %q", p.Name, v, p.Value))
+		}
+		delete(got, name)
+	}
+
+	var extra []string
+	for name, value := range got {
+		extra = append(extra, fmt.Sprintf("unexpected param %s=%q", name, value))
+	}
+	sort.Strings(extra)
+	return append(diffs, extra...)
+}
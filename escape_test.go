@@ -0,0 +1,94 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// escapeRoutes are the routes the routers are loaded with for the escape
+// probes.
+var escapeRoutes = []fixtures.Route{
+	{Method: "GET", Path: "/user/:name"},
+}
+
+// escapeProbes are requests with an escaped or special character in the value
+// of the name param. Routers might pass the value on as it is in the request
+// or decoded, or not match the request at all, e.g. if they split the decoded
+// path at the slashes.
+var escapeProbes = []struct {
+	name    string
+	path    string
+	raw     string // value of the param as in the request
+	decoded string // decoded value of the param
+}{
+	{"EscapedSlash", "/user/a%2Fb", "a%2Fb", "a/b"},
+	{"EscapedPercent", "/user/a%25b", "a%25b", "a%b"},
+	{"Plus", "/user/a+b", "a+b", "a b"},
+}
+
+// escapeRouter requests each of the escape probes from the router loaded with
+// the escapeRoutes and returns the outcomes, which are "raw" or "decoded" for
+// the value of the param, the escaped value if it is neither, "panic" or the
+// status code if the router did not match the request.
+func escapeRouter(router adapters.Router) []string {
+	h := router.Load(escapeRoutes, adapters.HandlerParams)
+
+	outcomes := make([]string, len(escapeProbes))
+	for i, p := range escapeProbes {
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					outcomes[i] = "panic"
+				}
+			}()
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest("GET", p.path, nil))
+			if w.Code != 200 {
+				outcomes[i] = fmt.Sprint(w.Code)
+				return
+			}
+			value := strings.TrimSuffix(w.Body.String(), "\n")
+			if i := strings.IndexByte(value, '='); i >= 0 {
+				value = value[i+1:]
+			}
+			switch value {
+			case p.raw:
+				outcomes[i] = "raw"
+			case p.decoded:
+				outcomes[i] = "decoded"
+			default:
+				outcomes[i] = url.QueryEscape(value)
+			}
+		}()
+	}
+	return outcomes
+}
+
+// TestEscapes checks that no router panics for the escape probes. With -v, it
+// logs the outcomes of the probes per router.
+func TestEscapes(t *testing.T) {
+	names := make([]string, len(escapeProbes))
+	for i, p := range escapeProbes {
+		names[i] = p.name
+	}
+	matrix := outcomeMatrix(names, func(router adapters.Router) []string {
+		outcomes := escapeRouter(router)
+		for i, outcome := range outcomes {
+			if outcome == "panic" {
+				t.Errorf("%s: GET %s: %s", router.Name, escapeProbes[i].path, outcome)
+			}
+		}
+		return outcomes
+	})
+	t.Logf("outcomes of the escape probes\n%s", matrix)
+}
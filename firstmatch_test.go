@@ -0,0 +1,60 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// BenchmarkFirstMatch measures loading every router with the routes of every
+// scenario it supports and serving the first request, e.g. as after a scale
+// from zero, named BenchmarkFirstMatch/<Router>/<Scenario>. The first request
+// is also reported on its own as first-ns/op, next to the second request as
+// next-ns/op, which tells the routers deferring work until the first request,
+// e.g. sorting the routes, apart.
+func BenchmarkFirstMatch(b *testing.B) {
+	for _, router := range routers {
+		b.Run(router.Name, func(b *testing.B) {
+			for _, s := range selectedScenarios() {
+				if router.Capabilities.Check(s.Routes()) != nil || !router.Capabilities.Supports(s.HandlerKind()) {
+					continue
+				}
+				b.Run(s.Name(), func(b *testing.B) {
+					benchFirstMatch(b, router, s.Routes(), s.HandlerKind())
+				})
+			}
+		})
+	}
+}
+
+// benchFirstMatch loads the router per op and requests one of the routes
+// twice, in turns.
+func benchFirstMatch(b *testing.B, router adapters.Router, routes []fixtures.Route, kind adapters.HandlerKind) {
+	w := new(mockResponseWriter)
+	src := newRequestSource(routes)
+	var first, next time.Duration
+
+	b.ReportAllocs()
+	for i, loop := 0, benchLoop(b); loop(); i++ {
+		h := router.Load(routes, kind)
+		r := src.request(i % len(routes))
+
+		start := time.Now()
+		h.ServeHTTP(w, r)
+		served := time.Now()
+		h.ServeHTTP(w, r)
+		first += served.Sub(start)
+		next += time.Since(served)
+
+		src.release(r)
+	}
+
+	b.ReportMetric(float64(first.Nanoseconds())/float64(b.N), "first-ns/op")
+	b.ReportMetric(float64(next.Nanoseconds())/float64(b.N), "next-ns/op")
+}
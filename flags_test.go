@@ -0,0 +1,293 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/scenarios"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	routesFile  = flag.String("routes", "", "benchmark the routes of a JSON or YAML `file` as an additional API")
+	openAPIFile = flag.String("openapi", "", "benchmark the routes of an OpenAPI 3 `document` as an additional API")
+	configFile  = flag.String("config", "", "benchmark the router variants of a JSON or YAML `file` as additional routers")
+
+	verifyFlag = flag.Bool("verify", false, "verify that the routers extract the params of the requests, in an untimed run before each benchmark")
+
+	listRoutersFlag = flag.Bool("list.routers", false, "list the selected routers and exit")
+	versionsFlag    = flag.Bool("versions", false, "name the routers after the versions of their modules, e.g. Gin@v1.5.0")
+	routersFlag     = flag.String("routers", "", "only benchmark and test the routers matching the `regexp`")
+	scenariosFlag   = flag.String("scenarios", "", "only benchmark and test the APIs matching the `regexp`")
+
+	// synthetic route set, which is benchmarked as the Synthetic API if
+	// -gen.routes is set
+	genConfig fixtures.GeneratorConfig
+
+	// request corpus, which is benchmarked as the Corpus request of every API
+	// if -corpus.requests is set
+	corpusConfig = fixtures.CorpusConfig{Methods: make(map[string]float64)}
+)
+
+func init() {
+	flag.IntVar(&genConfig.Routes, "gen.routes", 0, "number of routes of the synthetic API")
+	flag.IntVar(&genConfig.MaxDepth, "gen.depth", 5, "maximum number of path segments of the synthetic API")
+	flag.IntVar(&genConfig.Branching, "gen.branching", 10, "maximum number of static children of a segment of the synthetic API")
+	flag.Float64Var(&genConfig.ParamRatio, "gen.params", 0.2, "probability of a param segment in the synthetic API")
+	flag.Float64Var(&genConfig.CatchAllRatio, "gen.catchall", 0.02, "probability of a catch-all route in the synthetic API")
+	flag.Int64Var(&genConfig.Seed, "gen.seed", 1, "seed of the synthetic API")
+
+	flag.IntVar(&corpusConfig.Requests, "corpus.requests", 0, "number of requests of the request corpus")
+	flag.Float64Var(&corpusConfig.MissRatio, "corpus.miss", 0, "fraction of requests of the corpus matching no route")
+	flag.IntVar(&corpusConfig.ParamLenMin, "corpus.paramlen.min", 4, "minimum length of param values in the corpus")
+	flag.IntVar(&corpusConfig.ParamLenMax, "corpus.paramlen.max", 12, "maximum length of param values in the corpus")
+	flag.Var(methodWeights(corpusConfig.Methods), "corpus.methods", "relative weights of the methods in the corpus, e.g. GET=4,POST=1")
+	flag.Int64Var(&corpusConfig.Seed, "corpus.seed", 1, "seed of the request corpus")
+}
+
+// methodWeights is a flag.Value for weights of HTTP methods.
+type methodWeights map[string]float64
+
+func (m methodWeights) String() string {
+	methods := make([]string, 0, len(m))
+	for method, w := range m {
+		methods = append(methods, method+"="+strconv.FormatFloat(w, 'g', -1, 64))
+	}
+	sort.Strings(methods)
+	return strings.Join(methods, ",")
+}
+
+func (m methodWeights) Set(s string) error {
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid method weight %q", pair)
+		}
+		w, err := strconv.ParseFloat(kv[1], 64)
+		if err != nil || w < 0 {
+			return fmt.Errorf("invalid method weight %q", pair)
+		}
+		m[strings.ToUpper(kv[0])] = w
+	}
+	return nil
+}
+
+// routersRe and scenariosRe are the compiled -routers and -scenarios flags.
+var routersRe, scenariosRe *regexp.Regexp
+
+// routerSelected reports whether a router is selected by the -routers flag.
+func routerSelected(name string) bool {
+	return routersRe == nil || routersRe.MatchString(name)
+}
+
+// selectedScenarios returns the registered scenarios selected by the
+// -scenarios flag.
+func selectedScenarios() []scenarios.Scenario {
+	var selected []scenarios.Scenario
+	for _, s := range scenarios.All() {
+		if scenariosRe == nil || scenariosRe.MatchString(s.Name()) {
+			selected = append(selected, s)
+		}
+	}
+	return selected
+}
+
+// registerFlagScenarios registers the routers and scenarios requested by
+// flags, and drops the routers not selected by the -routers flag.
+func registerFlagScenarios() error {
+	var err error
+	if *routersFlag != "" {
+		if routersRe, err = regexp.Compile(*routersFlag); err != nil {
+			return fmt.Errorf("invalid -routers: %v", err)
+		}
+	}
+	if *scenariosFlag != "" {
+		if scenariosRe, err = regexp.Compile(*scenariosFlag); err != nil {
+			return fmt.Errorf("invalid -scenarios: %v", err)
+		}
+	}
+
+	if *configFile != "" {
+		if err := registerVariants(*configFile); err != nil {
+			return err
+		}
+	}
+	if *versionsFlag {
+		nameVersions()
+	}
+	selected := routers[:0]
+	for _, r := range routers {
+		if routerSelected(r.Name) {
+			selected = append(selected, r)
+		}
+	}
+	routers = selected
+
+	if *routesFile != "" {
+		if err := registerRoutesFile(*routesFile, fixtures.LoadFile); err != nil {
+			return err
+		}
+	}
+	if *openAPIFile != "" {
+		if err := registerRoutesFile(*openAPIFile, fixtures.LoadOpenAPI); err != nil {
+			return err
+		}
+	}
+	if genConfig.Routes > 0 {
+		if scenarioRegistered("Synthetic") {
+			return fmt.Errorf("-gen.routes: scenario Synthetic already registered, e.g. by -routes")
+		}
+		routes := fixtures.Generate(genConfig)
+		scenarios.Register(scenarios.New("Synthetic", routes, adapters.HandlerEmpty,
+			scenarios.Sequence("All", routes),
+		))
+	}
+	return nil
+}
+
+// registerRoutesFile registers a scenario for the routes of a file, named
+// after the file without its extension. It fails for the name of a registered
+// scenario, e.g. Github.json.
+func registerRoutesFile(filename string, load func(string) ([]fixtures.Route, error)) error {
+	routes, err := load(filename)
+	if err != nil {
+		return err
+	}
+
+	name := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	if scenarioRegistered(name) {
+		return fmt.Errorf("%s: scenario %s already registered, rename the file", filename, name)
+	}
+	scenarios.Register(scenarios.New(name, routes, adapters.HandlerEmpty,
+		scenarios.Sequence("All", routes),
+	))
+	return nil
+}
+
+// scenarioRegistered reports whether a scenario of the name is registered.
+func scenarioRegistered(name string) bool {
+	for _, s := range scenarios.All() {
+		if s.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// config is the format of the -config file.
+type config struct {
+	Variants []struct {
+		Name    string                 `yaml:"name"`
+		Router  string                 `yaml:"router"`
+		Options map[string]interface{} `yaml:"options"`
+	} `yaml:"variants"`
+}
+
+// loadVariants returns the router variants of a config file.
+func loadVariants(filename string) ([]adapters.Router, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	// YAML is a superset of JSON
+	var c config
+	if err := yaml.UnmarshalStrict(data, &c); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	var variants []adapters.Router
+	for _, v := range c.Variants {
+		if v.Name == "" {
+			return nil, fmt.Errorf("%s: variant of %s without name", filename, v.Router)
+		}
+		var variant *adapters.Router
+		for _, r := range adapters.Routers() {
+			if r.Name == v.Name {
+				return nil, fmt.Errorf("%s: variant %s: name of a router", filename, v.Name)
+			}
+			if r.Name == v.Router {
+				r, err := r.Variant(v.Name, adapters.Options(v.Options))
+				if err != nil {
+					return nil, fmt.Errorf("%s: variant %s: %v", filename, v.Name, err)
+				}
+				variant = &r
+			}
+		}
+		if variant == nil {
+			return nil, fmt.Errorf("%s: variant %s: unknown router %q", filename, v.Name, v.Router)
+		}
+		for _, prev := range variants {
+			if prev.Name == v.Name {
+				return nil, fmt.Errorf("%s: variant %s defined twice", filename, v.Name)
+			}
+		}
+		variants = append(variants, *variant)
+	}
+	return variants, nil
+}
+
+// registerVariants adds the router variants of a config file to the
+// benchmarked routers.
+func registerVariants(filename string) error {
+	variants, err := loadVariants(filename)
+	if err != nil {
+		return err
+	}
+	routers = append(routers, variants...)
+	sort.Slice(routers, func(i, j int) bool {
+		return routers[i].Name < routers[j].Name
+	})
+	return nil
+}
+
+// nameVersions appends the versions of their modules to the names of the
+// routers, so the results of different versions can be told apart.
+func nameVersions() {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+	versions := make(map[string]string, len(info.Deps))
+	for _, dep := range info.Deps {
+		v := dep.Version
+		if dep.Replace != nil && dep.Replace.Version != "" {
+			v = dep.Replace.Version
+		}
+		versions[dep.Path] = v
+	}
+	for i, r := range routers {
+		if v, ok := versions[r.Module]; ok && v != "" {
+			routers[i].Name = r.Name + "@" + v
+		}
+	}
+}
+
+// corpusRequest is the name of the request of the request corpus.
+const corpusRequest = "Corpus"
+
+// corpora holds the request corpus of each scenario, keyed by scenario name.
+var corpora = make(map[string][]fixtures.Route)
+
+// generateCorpora generates a request corpus for the routes of every scenario,
+// if requested by the -corpus.requests flag.
+func generateCorpora() {
+	if corpusConfig.Requests <= 0 {
+		return
+	}
+	for _, s := range selectedScenarios() {
+		corpora[s.Name()] = fixtures.GenerateRequests(s.Routes(), corpusConfig)
+	}
+}
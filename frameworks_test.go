@@ -0,0 +1,15 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build frameworks && !js && !wasip1
+// +build frameworks,!js,!wasip1
+
+package main
+
+// For WebAssembly, the frameworks build tag does not import all frameworks, as
+// some do not compile for it: Beego and GoFrame for js, Buffalo, Echo, Gin and
+// vulcand/route for wasip1 as well as Fiber, go-zero, Hertz and Iris for both.
+func init() {
+	allFrameworks = true
+}
@@ -0,0 +1,94 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package main
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// FuzzRouting loads every router with a mutated route and requests a mutated
+// path, which must not panic. Routes which are not valid in the canonical
+// syntax and paths which no server would accept are skipped.
+func FuzzRouting(f *testing.F) {
+	f.Add("/user/:name", "/user/gordon")
+	f.Add("/user/:name", "/user/%2F")
+	f.Add("/user/:name", "/user//")
+	f.Add("/src/*filepath", "/src/a/../b")
+	f.Add("/src/*filepath", "/src")
+	f.Add("/repos/:owner/:repo/events", "/repos/julienschmidt/httprouter/events?page=2")
+	f.Add("/", "//")
+	f.Add("/a.b/c-d", "/a.b/c-d/")
+
+	f.Fuzz(func(t *testing.T, route, path string) {
+		if !validRoute(route) {
+			t.Skip()
+		}
+		req, err := http.ReadRequest(bufio.NewReader(strings.NewReader("GET " + path + " HTTP/1.1\r\nHost: localhost\r\n\r\n")))
+		if err != nil {
+			t.Skip()
+		}
+
+		routes := []fixtures.Route{{Method: "GET", Path: route}}
+		for _, router := range routers {
+			if router.Capabilities.Check(routes) != nil {
+				continue
+			}
+			func() {
+				defer func() {
+					if err := recover(); err != nil {
+						t.Errorf("%s: GET %s for route %s: panic: %v", router.Name, path, route, err)
+					}
+				}()
+				h := router.Load(routes, adapters.HandlerTest)
+				h.ServeHTTP(httptest.NewRecorder(), req.Clone(req.Context()))
+			}()
+		}
+	})
+}
+
+// validRoute reports whether the route is valid in the canonical colon syntax
+// with conservative names: params are alphanumeric, catch-all params are last
+// and static segments consist of unreserved characters.
+func validRoute(route string) bool {
+	if !strings.HasPrefix(route, "/") {
+		return false
+	}
+	segments := strings.Split(route[1:], "/")
+	names := make(map[string]bool)
+	for i, s := range segments {
+		if s == "" {
+			// only a trailing slash
+			if i != len(segments)-1 {
+				return false
+			}
+			continue
+		}
+
+		chars := "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+		if s[0] == ':' || s[0] == '*' {
+			if len(s) < 2 || names[s[1:]] || s[0] == '*' && i != len(segments)-1 {
+				return false
+			}
+			names[s[1:]] = true
+			s = s[1:]
+		} else {
+			chars += ".-_~"
+		}
+		if strings.Trim(s, chars) != "" {
+			return false
+		}
+	}
+	return true
+}
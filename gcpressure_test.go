@@ -0,0 +1,82 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"runtime"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+)
+
+// gcPressurePercent is the GOGC of the GCPressure benchmarks.
+const gcPressurePercent = 20
+
+// BenchmarkGCPressure runs the All request of every scenario, as BenchmarkAPI
+// does, while the garbage collector runs with a GOGC of 20 and a goroutine
+// allocates in the background, named BenchmarkGCPressure/<Router>/<Scenario>.
+// Routers allocating per request trigger more collections than routers without
+// allocations, reported as the collections per op in gc/op. The B/op and
+// allocs/op include the allocations in the background.
+func BenchmarkGCPressure(b *testing.B) {
+	for _, router := range routers {
+		b.Run(router.Name, func(b *testing.B) {
+			for _, s := range selectedScenarios() {
+				if router.Capabilities.Check(s.Routes()) != nil || !router.Capabilities.Supports(s.HandlerKind()) {
+					continue
+				}
+				for _, req := range s.Requests() {
+					if req.Name != "All" {
+						continue
+					}
+					b.Run(s.Name(), func(b *testing.B) {
+						h := router.Load(s.Routes(), s.HandlerKind())
+
+						stop := make(chan struct{})
+						done := allocateInBackground(stop)
+						defer debug.SetGCPercent(debug.SetGCPercent(gcPressurePercent))
+						var before, after runtime.MemStats
+						runtime.ReadMemStats(&before)
+
+						w := benchRoutes(b, h, req.Routes)
+
+						runtime.ReadMemStats(&after)
+						close(stop)
+						<-done
+						b.ReportMetric(float64(after.NumGC-before.NumGC)/float64(b.N), "gc/op")
+						if s.HandlerKind() == adapters.HandlerEmpty {
+							checkResponse(b, w, 0)
+						}
+					})
+				}
+			}
+		})
+	}
+}
+
+// allocateInBackground allocates buffers of 4KB in a goroutine until stop is
+// closed, about 64MB per second, while keeping the last 4MB of them live. The
+// returned channel is closed, once the goroutine returned.
+func allocateInBackground(stop <-chan struct{}) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		live := make([][]byte, 1024)
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			live[i%len(live)] = make([]byte, 4096)
+			if i%16 == 0 {
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}()
+	return done
+}
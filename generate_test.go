@@ -0,0 +1,102 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/scenarios"
+)
+
+// Some external tooling expects the flat Benchmark<Router>_<Benchmark> names
+// of the benchmark functions. They are generated from the registered routers
+// and scenarios into a file, which is only built with the flatbench tag:
+//
+//	go test -tags flatbench -bench="Gin_"
+
+const flatBenchmarksFile = "bench_flat_test.go"
+
+// allFrameworks is set if all framework modules are imported, see
+// frameworks_test.go. The flat benchmarks are only generated for all of them.
+var allFrameworks bool
+
+// optInFrameworks is set if a framework outside of the frameworks tag is
+// imported, see adapter_revel_test.go. The flat benchmarks do not include it.
+var optInFrameworks bool
+
+var generate = flag.Bool("generate", false, "write the flat benchmark functions to "+flatBenchmarksFile)
+
+// flatBenchmarkName returns the name of a generated flat benchmark function.
+func flatBenchmarkName(router, benchmark string) string {
+	return "Benchmark" + router + "_" + benchmark
+}
+
+// generateFlatBenchmarks returns the source of the flat benchmark functions.
+func generateFlatBenchmarks() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by "go test -tags frameworks -run=^TestFlatBenchmarks$ -generate"; DO NOT EDIT.
+
+//go:build flatbench
+// +build flatbench
+
+package main
+
+import "testing"
+`)
+
+	for _, bm := range microBenchmarks {
+		fmt.Fprintf(&buf, "\n// Micro %s\n", bm.name)
+		for _, router := range routers {
+			fmt.Fprintf(&buf, "\nfunc %s(b *testing.B) {\n\tbenchMicroRouter(b, %q, %q)\n}\n",
+				flatBenchmarkName(router.Name, bm.name), router.Name, bm.name)
+		}
+	}
+
+	for _, s := range scenarios.All() {
+		for _, req := range s.Requests() {
+			fmt.Fprintf(&buf, "\n// %s %s\n", s.Name(), req.Name)
+			for _, router := range routers {
+				fmt.Fprintf(&buf, "\nfunc %s(b *testing.B) {\n\tbenchScenario(b, %q, %q, %q)\n}\n",
+					flatBenchmarkName(router.Name, s.Name()+req.Name), s.Name(), router.Name, req.Name)
+			}
+		}
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// TestFlatBenchmarks checks that the generated flat benchmark functions are in
+// sync with the registered routers and scenarios.
+// With the -generate flag, it writes them instead.
+func TestFlatBenchmarks(t *testing.T) {
+	if !allFrameworks || optInFrameworks || *configFile != "" || *routersFlag != "" {
+		t.Skip("flat benchmarks are generated for all frameworks without variants, run with -tags frameworks")
+	}
+
+	src, err := generateFlatBenchmarks()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *generate {
+		if err := ioutil.WriteFile(flatBenchmarksFile, src, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	existing, err := ioutil.ReadFile(flatBenchmarksFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(existing, src) {
+		t.Errorf("%s is out of date, run go generate", flatBenchmarksFile)
+	}
+}
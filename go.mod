@@ -4,30 +4,27 @@ go 1.13
 
 require (
 	github.com/BurntSushi/toml v0.3.1 // indirect
-	github.com/astaxie/beego v1.12.0
-	github.com/fsnotify/fsnotify v1.4.7 // indirect
-	github.com/gin-gonic/gin v1.5.0
+	github.com/ant0ine/go-json-rest v3.3.2+incompatible
+	github.com/bmizerany/pat v0.0.0-20210406213842-e4b6760bdd6f
+	github.com/codegangsta/inject v0.0.0-20150114235600-33e0aa1cb7c0 // indirect
+	github.com/dimfeld/httptreemux/v5 v5.5.0
+	github.com/emicklei/go-restful/v3 v3.13.0
 	github.com/go-chi/chi v4.0.2+incompatible
+	github.com/go-chi/chi/v5 v5.2.0
+	github.com/go-martini/martini v0.0.0-20170121215854-22fa46961aab
+	github.com/go-ozzo/ozzo-routing v2.1.4+incompatible
+	github.com/go-playground/pure v0.0.0-20190513234712-ab95fef1be7a
+	github.com/gocraft/web v0.0.0-20190207150652-9707327fb69b
 	github.com/gorilla/mux v1.7.3
-	github.com/inconshreveable/log15 v0.0.0-20180818164646-67afb5ed74ec // indirect
+	github.com/husobee/vestigo v1.1.1
 	github.com/julienschmidt/httprouter v1.3.0
-	github.com/labstack/echo/v4 v4.1.11
-	github.com/myesui/uuid v1.0.0 // indirect
-	github.com/revel/config v0.21.0 // indirect
-	github.com/revel/log15 v2.11.20+incompatible // indirect
-	github.com/revel/pathtree v0.0.0-20140121041023-41257a1839e9
-	github.com/revel/revel v0.21.0
-	github.com/shiena/ansicolor v0.0.0-20151119151921-a422bbe96644 // indirect
-	github.com/twinj/uuid v1.0.0 // indirect
-	github.com/unknwon/com v1.0.1 // indirect
-	github.com/xeonx/timeago v1.0.0-rc4 // indirect
+	github.com/kataras/muxie v1.1.2
+	github.com/matryer/way v0.0.0-20180416093233-9632d0c407b0
+	github.com/urfave/negroni v1.0.0
+	github.com/xujiajun/gorouter v1.2.0
+	github.com/zenazn/goji v1.0.1
+	goji.io/v3 v3.0.0
 	golang.org/x/crypto v0.0.0-20190927123631-a832865fa7ad // indirect
-	golang.org/x/net v0.0.0-20190926025831-c00fd9afed17 // indirect
 	golang.org/x/sys v0.0.0-20191025090151-53bf42e6b339 // indirect
-	gopkg.in/fsnotify/fsnotify.v1 v1.4.7 // indirect
-	gopkg.in/ini.v1 v1.48.0 // indirect
-	gopkg.in/macaron.v1 v1.3.4
-	gopkg.in/natefinch/lumberjack.v2 v2.0.0 // indirect
-	gopkg.in/stack.v0 v0.0.0-20141108040640-9b43fcefddd0 // indirect
-	gopkg.in/stretchr/testify.v1 v1.2.2 // indirect
+	gopkg.in/yaml.v2 v2.4.0
 )
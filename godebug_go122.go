@@ -0,0 +1,14 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build go1.22
+// +build go1.22
+
+// The ServeMux router registers patterns with methods and params, which
+// http.ServeMux only supports if the module declares Go 1.22 or later, unless
+// its former behavior is disabled explicitly.
+
+//go:debug httpmuxgo121=0
+
+package main
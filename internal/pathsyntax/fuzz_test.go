@@ -0,0 +1,65 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package pathsyntax
+
+import (
+	"strings"
+	"testing"
+)
+
+func FuzzTranslate(f *testing.F) {
+	for _, path := range []string{
+		"/",
+		"/user/:name",
+		"/files/:dir/*filepath",
+		"/:/*",
+		"/time/12:00/a*b",
+		"/user/{name}",
+		"/user/(?P<name>[^/]+)",
+	} {
+		f.Add(path)
+	}
+
+	f.Fuzz(func(t *testing.T, path string) {
+		for _, d := range []Dialect{Colon, ColonStar, ColonWildcard, ColonDoubleStar, Brace, BraceStar, BraceWildcard, BracePath, BraceRemainder, Angle, Regexp, Wildcard} {
+			translated := Translate(path, d)
+			if !strings.ContainsAny(path, ":*") && translated != path {
+				t.Errorf("Translate(%q, %s): got %q; expected the static path", path, d.Name, translated)
+			}
+
+			parsed, err := Parse(translated, d)
+			if err != nil {
+				continue // the dialect does not name its params
+			}
+			if again := Translate(parsed, d); again != translated {
+				t.Errorf("Translate(Parse(%q, %s)): got %q", translated, d.Name, again)
+			}
+			if plain(path) && parsed != path {
+				t.Errorf("Parse(Translate(%q, %s)): got %q", path, d.Name, parsed)
+			}
+		}
+	})
+}
+
+// plain reports whether none of the static segments of the path look like a
+// param of any dialect and all param names are alphanumeric, so that the path
+// must survive a round trip through each dialect.
+func plain(path string) bool {
+	for _, s := range strings.Split(path, "/") {
+		if len(s) > 1 && (s[0] == ':' || s[0] == '*') {
+			for _, r := range s[1:] {
+				if !(r == '_' || '0' <= r && r <= '9' || 'A' <= r && r <= 'Z' || 'a' <= r && r <= 'z') {
+					return false
+				}
+			}
+		} else if strings.ContainsAny(s, "{}<>()") {
+			return false
+		}
+	}
+	return true
+}
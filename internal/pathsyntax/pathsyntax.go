@@ -0,0 +1,186 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+// Package pathsyntax translates route paths from the canonical colon syntax
+// used by the benchmark fixtures to the path syntax of the benchmarked routers.
+//
+// In the canonical syntax a segment starting with a colon (/user/:name) is a
+// named parameter matching a single path segment, while a segment starting
+// with an asterisk (/src/*filepath) is a catch-all parameter matching the rest
+// of the path.
+package pathsyntax
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Dialect describes how a router expects parameters to be written.
+type Dialect struct {
+	// Name identifies the dialect in error messages.
+	Name string
+
+	// Param formats a named parameter matching a single path segment.
+	Param func(name string) string
+
+	// CatchAll formats a named parameter matching the rest of the path.
+	CatchAll func(name string) string
+}
+
+var (
+	// Colon is the canonical syntax: /user/:name and /src/*filepath.
+	Colon = Dialect{"colon", prefix(":"), prefix("*")}
+
+	// ColonStar is used e.g. by Beego: /user/:name and /src/*.
+	ColonStar = Dialect{"colon-star", prefix(":"), constant("*")}
+
+	// ColonWildcard is used e.g. by gocraft/web: /user/:name and /src/:*.
+	ColonWildcard = Dialect{"colon-wildcard", prefix(":"), constant(":*")}
+
+	// ColonDoubleStar is used e.g. by Martini: /user/:name and /src/**.
+	ColonDoubleStar = Dialect{"colon-double-star", prefix(":"), constant("**")}
+
+	// Brace is used e.g. by gorilla/mux: /user/{name} and /src/{filepath:.*}.
+	Brace = Dialect{"brace", enclose("{", "}"), enclose("{", ":.*}")}
+
+	// BraceStar is used e.g. by chi: /user/{name} and /src/*.
+	BraceStar = Dialect{"brace-star", enclose("{", "}"), constant("*")}
+
+	// BraceWildcard is used e.g. by fasthttp/router: /user/{name} and
+	// /src/{filepath:*}.
+	BraceWildcard = Dialect{"brace-wildcard", enclose("{", "}"), enclose("{", ":*}")}
+
+	// BracePath is used e.g. by Iris: /user/{name} and /src/{filepath:path}.
+	BracePath = Dialect{"brace-path", enclose("{", "}"), enclose("{", ":path}")}
+
+	// BraceRemainder is used e.g. by http.ServeMux since Go 1.22: /user/{name}
+	// and /src/{filepath...}.
+	BraceRemainder = Dialect{"brace-remainder", enclose("{", "}"), enclose("{", "...}")}
+
+	// Angle is used e.g. by ozzo-routing: /user/<name> and /src/<filepath:.*>.
+	Angle = Dialect{"angle", enclose("<", ">"), enclose("<", ":.*>")}
+
+	// Regexp uses named capture groups: /user/(?P<name>[^/]+) and
+	// /src/(?P<filepath>.*).
+	Regexp = Dialect{"regexp", enclose("(?P<", ">[^/]+)"), enclose("(?P<", ">.*)")}
+
+	// Wildcard uses unnamed wildcards: /user/* and /src/*.
+	// The parameters are only accessible by their position.
+	Wildcard = Dialect{"wildcard", constant("*"), constant("*")}
+)
+
+func prefix(p string) func(string) string {
+	return func(name string) string {
+		return p + name
+	}
+}
+
+func enclose(open, close string) func(string) string {
+	return func(name string) string {
+		return open + name + close
+	}
+}
+
+func constant(s string) func(string) string {
+	return func(string) string {
+		return s
+	}
+}
+
+// Translate converts a path from the canonical colon syntax to the dialect.
+// Static segments are left unchanged.
+func Translate(path string, d Dialect) string {
+	if !strings.ContainsAny(path, ":*") {
+		return path
+	}
+
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if len(s) < 2 {
+			continue
+		}
+		switch s[0] {
+		case ':':
+			segments[i] = d.Param(s[1:])
+		case '*':
+			segments[i] = d.CatchAll(s[1:])
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// marker stands in for the name of a parameter to derive the template of a
+// dialect.
+const marker = "\x00"
+
+// template returns the text around the name of a parameter formatted by f.
+func template(f func(name string) string) (before, after string, ok bool) {
+	s := f(marker)
+	i := strings.Index(s, marker)
+	if i < 0 {
+		return "", "", false
+	}
+	return s[:i], s[i+len(marker):], true
+}
+
+// Parse converts a path from the dialect back to the canonical colon syntax,
+// such that Parse(Translate(path, d), d) is path, unless a static segment of
+// path looks like a parameter of the dialect. It fails for dialects which do
+// not name their parameters, like Wildcard.
+func Parse(path string, d Dialect) (string, error) {
+	pb, pa, ok := template(d.Param)
+	if !ok {
+		return "", fmt.Errorf("pathsyntax: dialect %s does not name its params", d.Name)
+	}
+	cb, ca, ok := template(d.CatchAll)
+	if !ok {
+		return "", fmt.Errorf("pathsyntax: dialect %s does not name its catch-all params", d.Name)
+	}
+
+	// the templates might contain slashes, e.g. (?P<name>[^/]+), thus the
+	// path is scanned instead of split into segments
+	var b strings.Builder
+	for len(path) > 0 {
+		// catch-all params first, as e.g. {filepath:.*} is also a valid
+		// param of the brace dialect
+		if name, n := param(path, cb, ca); n > 0 {
+			b.WriteString("*" + name)
+			path = path[n:]
+		} else if name, n := param(path, pb, pa); n > 0 {
+			b.WriteString(":" + name)
+			path = path[n:]
+		} else {
+			n := strings.IndexByte(path, '/')
+			if n < 0 {
+				n = len(path)
+			}
+			b.WriteString(path[:n])
+			path = path[n:]
+		}
+		if len(path) > 0 {
+			// path starts with the slash of the next segment
+			b.WriteByte('/')
+			path = path[1:]
+		}
+	}
+	return b.String(), nil
+}
+
+// param returns the name of the param formatted as before+name+after, which
+// the segment at the start of path consists of, and the length of the segment.
+func param(path, before, after string) (name string, n int) {
+	if !strings.HasPrefix(path, before) {
+		return "", 0
+	}
+	for i := len(before) + 1; i <= len(path); i++ {
+		if path[i-1] == '/' {
+			break // names can not contain slashes
+		}
+		end := i + len(after)
+		if strings.HasPrefix(path[i:], after) && (end == len(path) || path[end] == '/') {
+			return path[len(before):i], end
+		}
+	}
+	return "", 0
+}
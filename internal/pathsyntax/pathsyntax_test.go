@@ -0,0 +1,248 @@
+// Copyright 2014 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package pathsyntax
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected map[string]string // by dialect name
+	}{
+		{"/", nil},
+		{"/user/repos", nil},
+		{"/progs/json2.go", nil},
+		{"/user/:name", map[string]string{
+			"colon":             "/user/:name",
+			"colon-star":        "/user/:name",
+			"colon-wildcard":    "/user/:name",
+			"colon-double-star": "/user/:name",
+			"brace":             "/user/{name}",
+			"brace-star":        "/user/{name}",
+			"brace-wildcard":    "/user/{name}",
+			"brace-path":        "/user/{name}",
+			"brace-remainder":   "/user/{name}",
+			"angle":             "/user/<name>",
+			"regexp":            "/user/(?P<name>[^/]+)",
+			"wildcard":          "/user/*",
+		}},
+		{"/repos/:owner/:repo/events", map[string]string{
+			"colon":             "/repos/:owner/:repo/events",
+			"colon-star":        "/repos/:owner/:repo/events",
+			"colon-wildcard":    "/repos/:owner/:repo/events",
+			"colon-double-star": "/repos/:owner/:repo/events",
+			"brace":             "/repos/{owner}/{repo}/events",
+			"brace-star":        "/repos/{owner}/{repo}/events",
+			"brace-wildcard":    "/repos/{owner}/{repo}/events",
+			"brace-path":        "/repos/{owner}/{repo}/events",
+			"brace-remainder":   "/repos/{owner}/{repo}/events",
+			"angle":             "/repos/<owner>/<repo>/events",
+			"regexp":            "/repos/(?P<owner>[^/]+)/(?P<repo>[^/]+)/events",
+			"wildcard":          "/repos/*/*/events",
+		}},
+		{"/user/:name/", map[string]string{
+			"colon":             "/user/:name/",
+			"colon-star":        "/user/:name/",
+			"colon-wildcard":    "/user/:name/",
+			"colon-double-star": "/user/:name/",
+			"brace":             "/user/{name}/",
+			"brace-star":        "/user/{name}/",
+			"brace-wildcard":    "/user/{name}/",
+			"brace-path":        "/user/{name}/",
+			"brace-remainder":   "/user/{name}/",
+			"angle":             "/user/<name>/",
+			"regexp":            "/user/(?P<name>[^/]+)/",
+			"wildcard":          "/user/*/",
+		}},
+		{"/src/*filepath", map[string]string{
+			"colon":             "/src/*filepath",
+			"colon-star":        "/src/*",
+			"colon-wildcard":    "/src/:*",
+			"colon-double-star": "/src/**",
+			"brace":             "/src/{filepath:.*}",
+			"brace-star":        "/src/*",
+			"brace-wildcard":    "/src/{filepath:*}",
+			"brace-path":        "/src/{filepath:path}",
+			"brace-remainder":   "/src/{filepath...}",
+			"angle":             "/src/<filepath:.*>",
+			"regexp":            "/src/(?P<filepath>.*)",
+			"wildcard":          "/src/*",
+		}},
+		{"/files/:dir/*filepath", map[string]string{
+			"colon":             "/files/:dir/*filepath",
+			"colon-star":        "/files/:dir/*",
+			"colon-wildcard":    "/files/:dir/:*",
+			"colon-double-star": "/files/:dir/**",
+			"brace":             "/files/{dir}/{filepath:.*}",
+			"brace-star":        "/files/{dir}/*",
+			"brace-wildcard":    "/files/{dir}/{filepath:*}",
+			"brace-path":        "/files/{dir}/{filepath:path}",
+			"brace-remainder":   "/files/{dir}/{filepath...}",
+			"angle":             "/files/<dir>/<filepath:.*>",
+			"regexp":            "/files/(?P<dir>[^/]+)/(?P<filepath>.*)",
+			"wildcard":          "/files/*/*",
+		}},
+		{"/:a/:b/:c/:d/:e", map[string]string{
+			"colon":             "/:a/:b/:c/:d/:e",
+			"colon-star":        "/:a/:b/:c/:d/:e",
+			"colon-wildcard":    "/:a/:b/:c/:d/:e",
+			"colon-double-star": "/:a/:b/:c/:d/:e",
+			"brace":             "/{a}/{b}/{c}/{d}/{e}",
+			"brace-star":        "/{a}/{b}/{c}/{d}/{e}",
+			"brace-wildcard":    "/{a}/{b}/{c}/{d}/{e}",
+			"brace-path":        "/{a}/{b}/{c}/{d}/{e}",
+			"brace-remainder":   "/{a}/{b}/{c}/{d}/{e}",
+			"angle":             "/<a>/<b>/<c>/<d>/<e>",
+			"regexp":            "/(?P<a>[^/]+)/(?P<b>[^/]+)/(?P<c>[^/]+)/(?P<d>[^/]+)/(?P<e>[^/]+)",
+			"wildcard":          "/*/*/*/*/*",
+		}},
+		// a lone colon or asterisk is not a parameter
+		{"/:/*", nil},
+		// colons and asterisks within a segment are not a parameter either
+		{"/time/12:00/a*b", nil},
+	}
+
+	dialects := []Dialect{Colon, ColonStar, ColonWildcard, ColonDoubleStar, Brace, BraceStar, BraceWildcard, BracePath, BraceRemainder, Angle, Regexp, Wildcard}
+
+	for _, test := range tests {
+		for _, d := range dialects {
+			expected := test.path
+			if test.expected != nil {
+				var ok bool
+				if expected, ok = test.expected[d.Name]; !ok {
+					t.Fatalf("missing expectation for %s in dialect %s", test.path, d.Name)
+				}
+			}
+			if got := Translate(test.path, d); got != expected {
+				t.Errorf("Translate(%q, %s): got %q; expected %q", test.path, d.Name, got, expected)
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	paths := []string{
+		"/",
+		"/user/repos",
+		"/user/:name",
+		"/user/:name/",
+		"/repos/:owner/:repo/events",
+		"/files/:dir/*filepath",
+		"/:/*",
+		"/time/12:00/a*b",
+	}
+
+	for _, d := range []Dialect{Colon, Brace, BraceWildcard, BracePath, BraceRemainder, Angle, Regexp} {
+		for _, path := range paths {
+			got, err := Parse(Translate(path, d), d)
+			if err != nil {
+				t.Fatalf("Parse(Translate(%q, %s)): %v", path, d.Name, err)
+			}
+			if got != path {
+				t.Errorf("Parse(Translate(%q, %s)): got %q", path, d.Name, got)
+			}
+		}
+	}
+
+	// params are not named by the dialects, thus they can not be parsed
+	for _, d := range []Dialect{ColonStar, ColonWildcard, ColonDoubleStar, BraceStar, Wildcard} {
+		if _, err := Parse("/user/*", d); err == nil {
+			t.Errorf("Parse in dialect %s: expected error", d.Name)
+		}
+	}
+}
+
+// route is a random path in the canonical syntax with alphanumeric param
+// names and static segments, which do not look like params of any dialect.
+type route string
+
+const routeChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_"
+
+// Generate implements quick.Generator.
+func (route) Generate(rand *rand.Rand, size int) reflect.Value {
+	word := func() string {
+		b := make([]byte, 1+rand.Intn(8))
+		for i := range b {
+			b[i] = routeChars[rand.Intn(len(routeChars))]
+		}
+		return string(b)
+	}
+
+	var b strings.Builder
+	n := rand.Intn(8)
+	for i := 0; i < n; i++ {
+		b.WriteByte('/')
+		switch x := rand.Intn(10); {
+		case x < 3:
+			b.WriteString(":" + word())
+		case x < 4 && i == n-1:
+			b.WriteString("*" + word())
+		default:
+			b.WriteString(word())
+		}
+	}
+	if b.Len() == 0 || rand.Intn(10) == 0 {
+		b.WriteByte('/')
+	}
+	return reflect.ValueOf(route(b.String()))
+}
+
+func TestTranslateProperties(t *testing.T) {
+	dialects := []Dialect{Colon, ColonStar, ColonWildcard, ColonDoubleStar, Brace, BraceStar, BraceWildcard, BracePath, BraceRemainder, Angle, Regexp, Wildcard}
+
+	// dialects which name their params translate back to the same path
+	roundTrip := func(r route) bool {
+		for _, d := range dialects {
+			path := string(r)
+			if parsed, err := Parse(Translate(path, d), d); err == nil && parsed != path {
+				t.Logf("Parse(Translate(%q, %s)): got %q", path, d.Name, parsed)
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+
+	// in dialects without slashes in params, each segment is translated on
+	// its own: static segments are unchanged and params keep their name
+	segments := func(r route) bool {
+		for _, d := range dialects {
+			if strings.Contains(d.Param("name")+d.CatchAll("name"), "/") {
+				continue
+			}
+			path := string(r)
+			original := strings.Split(path, "/")
+			translated := strings.Split(Translate(path, d), "/")
+			if len(translated) != len(original) {
+				t.Logf("Translate(%q, %s): got %d segments", path, d.Name, len(translated))
+				return false
+			}
+			for i, s := range original {
+				expected := s
+				if len(s) > 1 && s[0] == ':' {
+					expected = d.Param(s[1:])
+				} else if len(s) > 1 && s[0] == '*' {
+					expected = d.CatchAll(s[1:])
+				}
+				if translated[i] != expected {
+					t.Logf("Translate(%q, %s): got segment %q; expected %q", path, d.Name, translated[i], expected)
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if err := quick.Check(segments, nil); err != nil {
+		t.Error(err)
+	}
+}
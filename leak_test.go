@@ -0,0 +1,100 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// TestLeaks loads each router with the routes of all scenarios and serves a
+// request per route, after which no goroutines or file descriptors of the
+// router may be left, as they would affect the subsequent benchmarks. Tickers
+// and timers are only found if a goroutine waits for them.
+func TestLeaks(t *testing.T) {
+	for _, router := range routers {
+		goroutines := goroutineStacks()
+		fds := openFiles()
+
+		for _, s := range selectedScenarios() {
+			if router.Capabilities.Check(s.Routes()) != nil {
+				continue // logged by testRouter
+			}
+			exercise(router, s.Routes())
+		}
+		exercise(router, semanticsRoutes)
+
+		// give the goroutines, which are done, time to exit
+		var leaked []string
+		for wait := time.Millisecond; wait < time.Second; wait *= 2 {
+			leaked = leaked[:0]
+			for id, stack := range goroutineStacks() {
+				if _, ok := goroutines[id]; !ok {
+					leaked = append(leaked, stack)
+				}
+			}
+			if len(leaked) == 0 {
+				break
+			}
+			time.Sleep(wait)
+		}
+		for _, stack := range leaked {
+			t.Errorf("%s leaked a goroutine:\n%s", router.Name, stack)
+		}
+		if n := openFiles(); n > fds {
+			t.Errorf("%s leaked %d file descriptors", router.Name, n-fds)
+		}
+	}
+}
+
+// exercise loads the router with the routes and requests the sample request
+// of every route.
+func exercise(router adapters.Router, routes []fixtures.Route) {
+	h := router.Load(routes, adapters.HandlerParams)
+	for _, route := range routes {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(route.Method, fixtures.NewSample(route).Path, nil))
+	}
+}
+
+// goroutineStacks returns the stacks of all goroutines by their header line,
+// e.g. "goroutine 7", without the state.
+func goroutineStacks() map[string]string {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	stacks := make(map[string]string)
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		if i := strings.Index(stack, " ["); i > 0 {
+			stacks[stack[:i]] = stack
+		}
+	}
+	return stacks
+}
+
+// openFiles returns the number of open file descriptors of the process, or 0
+// if the system does not tell it.
+func openFiles() int {
+	f, err := os.Open("/proc/self/fd")
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+	names, _ := f.Readdirnames(-1)
+	return len(names) - 1 // without f
+}
@@ -0,0 +1,20 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build go1.24
+// +build go1.24
+
+package main
+
+import "testing"
+
+// benchLoop returns the condition of the loop of a benchmark, b.Loop, which
+// resets the timer before the first iteration and stops it after the last one.
+// In contrast to a loop over b.N, the compiler keeps the calls in the loop,
+// even if their results are not used, and the benchmark function runs only
+// once, so that the work before the loop, e.g. loading a router, is not
+// repeated. After the loop, b.N is the number of iterations.
+func benchLoop(b *testing.B) func() bool {
+	return b.Loop
+}
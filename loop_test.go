@@ -0,0 +1,28 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+//go:build !go1.24
+// +build !go1.24
+
+package main
+
+import "testing"
+
+// benchLoop returns the condition of the loop of a benchmark, which loops b.N
+// times as b.Loop of Go 1.24 does, resetting the timer before the first
+// iteration and stopping it after the last one.
+func benchLoop(b *testing.B) func() bool {
+	i := 0
+	return func() bool {
+		if i == 0 {
+			b.ResetTimer()
+		}
+		if i < b.N {
+			i++
+			return true
+		}
+		b.StopTimer()
+		return false
+	}
+}
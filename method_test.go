@@ -0,0 +1,75 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// methodRoutes are routes with the same paths for several methods.
+var methodRoutes = []fixtures.Route{
+	{Method: "GET", Path: "/user/:name"},
+	{Method: "POST", Path: "/user/:name"},
+	{Method: "GET", Path: "/users"},
+	{Method: "POST", Path: "/users"},
+	{Method: "DELETE", Path: "/users"},
+}
+
+// TestMethodDispatch checks that the routers serve each request by the handler
+// of the route with the method of the request, i.e. that the adapters register
+// the routes for their method only.
+func TestMethodDispatch(t *testing.T) {
+	for _, router := range routers {
+		for _, err := range dispatch(router, methodRoutes) {
+			t.Errorf("%s: %s", router.Name, err)
+		}
+		for _, s := range selectedScenarios() {
+			if router.Capabilities.Check(s.Routes()) != nil {
+				continue // logged by testRouter
+			}
+			for _, err := range dispatch(router, s.Routes()) {
+				t.Errorf("%s in scenario %s: %s", router.Name, s.Name(), err)
+			}
+		}
+	}
+}
+
+// dispatch requests the sample request of every route from the router, loaded
+// with the routes, and returns the requests served by a handler of a route for
+// another method.
+func dispatch(router adapters.Router, routes []fixtures.Route) []string {
+	h := router.Load(routes, adapters.HandlerMethod)
+
+	var errs []string
+	for _, route := range routes {
+		sample := fixtures.NewSample(route)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(route.Method, sample.Path, nil))
+		if w.Code != 200 || w.Body.String() != route.Method {
+			errs = append(errs, fmt.Sprintf("%s %s: status %d, handler of %q", route.Method, sample.Path, w.Code, w.Body.String()))
+		}
+	}
+	return errs
+}
+
+// dispatchSingle is dispatch for the router loaded with one route at a time by
+// LoadSingle.
+func dispatchSingle(router adapters.Router, routes []fixtures.Route) []string {
+	var errs []string
+	for _, route := range routes {
+		errs = append(errs, dispatch(adapters.Router{
+			Load: func(routes []fixtures.Route, kind adapters.HandlerKind) http.Handler {
+				return router.LoadSingle(route.Method, route.Path, kind)
+			},
+		}, []fixtures.Route{route})...)
+	}
+	return errs
+}
@@ -0,0 +1,136 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+)
+
+// requestSnapshot holds the parts of a request, which a router might modify.
+type requestSnapshot struct {
+	method     string
+	url        url.URL
+	requestURI string
+	host       string
+	header     http.Header
+	ctx        context.Context
+	form       url.Values
+	postForm   url.Values
+}
+
+func snapshotRequest(r *http.Request) requestSnapshot {
+	return requestSnapshot{
+		method:     r.Method,
+		url:        *r.URL,
+		requestURI: r.RequestURI,
+		host:       r.Host,
+		header:     r.Header.Clone(),
+		ctx:        r.Context(),
+		form:       r.Form,
+		postForm:   r.PostForm,
+	}
+}
+
+// diff returns the modifications of the request since the snapshot.
+func (s requestSnapshot) diff(r *http.Request) []string {
+	var diffs []string
+	if r.Method != s.method {
+		diffs = append(diffs, fmt.Sprintf("method %s -> %s", s.method, r.Method))
+	}
+	if *r.URL != s.url {
+		diffs = append(diffs, fmt.Sprintf("URL %s -> %s", s.url.String(), r.URL.String()))
+	}
+	if r.RequestURI != s.requestURI {
+		diffs = append(diffs, fmt.Sprintf("RequestURI %s -> %s", s.requestURI, r.RequestURI))
+	}
+	if r.Host != s.host {
+		diffs = append(diffs, fmt.Sprintf("Host %s -> %s", s.host, r.Host))
+	}
+	if !reflect.DeepEqual(r.Header, s.header) {
+		diffs = append(diffs, fmt.Sprintf("header %v -> %v", s.header, r.Header))
+	}
+	if r.Context() != s.ctx {
+		diffs = append(diffs, "context replaced")
+	}
+	if !reflect.DeepEqual(r.Form, s.form) || !reflect.DeepEqual(r.PostForm, s.postForm) {
+		diffs = append(diffs, "form parsed")
+	}
+	return diffs
+}
+
+// knownMutations are the modifications of the requests by routers, which the
+// results of the router in the reuse request mode are subject to. An empty
+// method matches every method, and diff matches the modifications it is a
+// prefix of.
+var knownMutations = []struct {
+	router string
+	method string
+	diff   string
+}{
+	// Beego parses the form of POST requests, which is then already parsed
+	// for the subsequent requests
+	{"Beego", "POST", "form parsed"},
+	// Buffalo appends a slash to the path of the URL, which the reuse request
+	// mode resets for every request, and parses the form of every request,
+	// which is then already parsed for the subsequent POST requests
+	{"Buffalo", "", "URL "},
+	{"Buffalo", "", "form parsed"},
+	// GoFrame stores its request in the context of the request, unless the
+	// context holds one already, which the reuse request mode keeps from the
+	// first request
+	{"GoFrame", "", "context replaced"},
+	// pat and gorilla/pat add the params to the query of the URL, which the
+	// reuse request mode resets for every request
+	{"Pat", "", "URL "},
+	{"GorillaPat", "", "URL "},
+	// vestigo adds the params to the query of the URL as well
+	{"Vestigo", "", "URL "},
+}
+
+func knownMutation(router, method, diff string) bool {
+	for _, m := range knownMutations {
+		if m.router == router && (m.method == "" || m.method == method) && strings.HasPrefix(diff, m.diff) {
+			return true
+		}
+	}
+	return false
+}
+
+// TestRequestMutation checks that the routers do not modify the requests they
+// serve, e.g. their URL or context, since the benchmarks pass the same request
+// for every route in the default reuse request mode. With -v, it logs the
+// knownMutations.
+func TestRequestMutation(t *testing.T) {
+	for _, router := range routers {
+		for _, s := range selectedScenarios() {
+			if router.Capabilities.Check(s.Routes()) != nil {
+				continue // logged by testRouter
+			}
+			h := router.Load(s.Routes(), adapters.HandlerEmpty)
+			src := newRequestSource(s.Routes())
+			for i, route := range s.Routes() {
+				r := src.request(i)
+				snapshot := snapshotRequest(r)
+				h.ServeHTTP(new(mockResponseWriter), r)
+				for _, diff := range snapshot.diff(r) {
+					if knownMutation(router.Name, route.Method, diff) {
+						t.Logf("%s in scenario %s modified the request of %s %s: %s", router.Name, s.Name(), route.Method, route.Path, diff)
+						continue
+					}
+					t.Errorf("%s in scenario %s modified the request of %s %s: %s", router.Name, s.Name(), route.Method, route.Path, diff)
+				}
+				src.release(r)
+			}
+		}
+	}
+}
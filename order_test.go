@@ -0,0 +1,105 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// BenchmarkInsertionOrder loads every router with the routes of every scenario
+// in each of the fixtures.Orders per op and requests all routes, named
+// BenchmarkInsertionOrder/<Router>/<Scenario><Order>, e.g. GithubSpecificLast.
+// The registration and the lookups are also reported on their own as
+// load-ns/op and lookup-ns/op, and the tree of routers declaring their node
+// type as nodes and depth, which tell the routers whose trees depend on the
+// order of the registration apart.
+func BenchmarkInsertionOrder(b *testing.B) {
+	for _, router := range routers {
+		b.Run(router.Name, func(b *testing.B) {
+			for _, s := range selectedScenarios() {
+				if router.Capabilities.Check(s.Routes()) != nil || !router.Capabilities.Supports(s.HandlerKind()) {
+					continue
+				}
+				for _, order := range fixtures.Orders {
+					ordered := order.Apply(s.Routes())
+					if router.Capabilities.Check(ordered) != nil {
+						continue
+					}
+					b.Run(s.Name()+order.Name, func(b *testing.B) {
+						benchInsertionOrder(b, router, s.Routes(), ordered, s.HandlerKind())
+					})
+				}
+			}
+		})
+	}
+}
+
+// benchInsertionOrder loads the router with the ordered routes per op and
+// requests the routes in their declared order.
+func benchInsertionOrder(b *testing.B, router adapters.Router, routes, ordered []fixtures.Route, kind adapters.HandlerKind) {
+	h, err := tryLoad(router, ordered, kind)
+	if err != nil {
+		b.Skipf("%s does not support the order: %v", router.Name, err)
+	}
+	stats, tree := router.Tree(h)
+
+	w := new(mockResponseWriter)
+	src := newRequestSource(routes)
+	var load, lookup time.Duration
+
+	b.ReportAllocs()
+	for loop := benchLoop(b); loop(); {
+		start := time.Now()
+		h := router.Load(ordered, kind)
+		loaded := time.Now()
+		for j := range routes {
+			r := src.request(j)
+			h.ServeHTTP(w, r)
+			src.release(r)
+		}
+		load += loaded.Sub(start)
+		lookup += time.Since(loaded)
+	}
+
+	b.ReportMetric(float64(load.Nanoseconds())/float64(b.N), "load-ns/op")
+	b.ReportMetric(float64(lookup.Nanoseconds())/float64(b.N), "lookup-ns/op")
+	if tree {
+		b.ReportMetric(float64(stats.Nodes), "nodes")
+		b.ReportMetric(float64(stats.Depth), "depth")
+	}
+	if kind == adapters.HandlerEmpty {
+		checkResponse(b, w, 0)
+	}
+}
+
+// TestInsertionOrder checks that the routers extract the params of every route
+// regardless of the order of the registration.
+func TestInsertionOrder(t *testing.T) {
+	for _, router := range routers {
+		for _, s := range selectedScenarios() {
+			if router.Capabilities.Check(s.Routes()) != nil {
+				continue // logged by testRouter
+			}
+			for _, order := range fixtures.Orders[1:] {
+				ordered := order.Apply(s.Routes())
+				if err := router.Capabilities.Check(ordered); err != nil {
+					t.Logf("%s in scenario %s%s: skipped, %v", router.Name, s.Name(), order.Name, err)
+					continue
+				}
+				if _, err := tryLoad(router, ordered, adapters.HandlerParams); err != nil {
+					t.Errorf("%s in scenario %s%s: %v", router.Name, s.Name(), order.Name, err)
+					continue
+				}
+				for _, caveat := range conformance(router, ordered) {
+					t.Errorf("%s in scenario %s%s: %s", router.Name, s.Name(), order.Name, caveat)
+				}
+			}
+		}
+	}
+}
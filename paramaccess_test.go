@@ -0,0 +1,74 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// paramAccessBatch is the number of requests served by the same handler in a
+// row, which are timed together.
+const paramAccessBatch = 64
+
+// BenchmarkParamAccess compares reading the name param with the own accessor
+// of a router and from the request context, for the routers providing both,
+// named BenchmarkParamAccess/<Router>/Param. Each op serves a request by both
+// handlers, which are reported as native-ns/op and context-ns/op, so that
+// their difference is the cost of the request context.
+func BenchmarkParamAccess(b *testing.B) {
+	for _, router := range routers {
+		if !router.Capabilities.Supports(adapters.HandlerContextWrite) {
+			continue
+		}
+		b.Run(router.Name, func(b *testing.B) {
+			b.Run("Param", func(b *testing.B) {
+				benchParamAccess(b, router)
+			})
+		})
+	}
+}
+
+func benchParamAccess(b *testing.B, router adapters.Router) {
+	native := router.LoadSingle("GET", "/user/:name", adapters.HandlerWrite)
+	context := router.LoadSingle("GET", "/user/:name", adapters.HandlerContextWrite)
+	w := new(mockResponseWriter)
+	src := newRequestSource([]fixtures.Route{{Method: "GET", Path: "/user/gordon"}})
+	var nativeTime, contextTime time.Duration
+
+	serve := func(h http.Handler, n int) time.Duration {
+		start := time.Now()
+		for i := 0; i < n; i++ {
+			r := src.request(0)
+			h.ServeHTTP(w, r)
+			src.release(r)
+		}
+		return time.Since(start)
+	}
+
+	b.ReportAllocs()
+
+	pending := 0
+	for loop := benchLoop(b); loop(); {
+		pending++
+		if pending == paramAccessBatch {
+			nativeTime += serve(native, pending)
+			contextTime += serve(context, pending)
+			pending = 0
+		}
+	}
+
+	// The loop does not tell which iteration is the last one, so the requests
+	// of the last, partial batch are served after it.
+	nativeTime += serve(native, pending)
+	contextTime += serve(context, pending)
+	b.ReportMetric(float64(nativeTime.Nanoseconds())/float64(b.N), "native-ns/op")
+	b.ReportMetric(float64(contextTime.Nanoseconds())/float64(b.N), "context-ns/op")
+	checkResponse(b, w, 2*len("gordon"))
+}
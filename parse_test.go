@@ -0,0 +1,20 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/scenarios"
+)
+
+func init() {
+	scenarios.Register(scenarios.New("Parse", fixtures.ParseAPI, adapters.HandlerEmpty,
+		scenarios.Get("Static", "/1/users"),
+		scenarios.Get("Param", "/1/classes/go"),
+		scenarios.Get("Param2", "/1/classes/go/123456789"),
+		scenarios.Sequence("All", fixtures.ParseAPI),
+	))
+}
@@ -0,0 +1,85 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// postBody is the 1KB form sent with the requests of the PostBody benchmarks.
+var postBody = []byte("body=" + strings.Repeat("x", 1024-len("body=")))
+
+// countingBody is a request body, which counts the bytes read from it.
+type countingBody struct {
+	bytes.Reader
+	read int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	b.read += int64(n)
+	return n, err
+}
+
+func (b *countingBody) Close() error { return nil }
+
+// BenchmarkPostBody requests every POST route of every scenario with a form
+// body per op, named BenchmarkPostBody/<Router>/<Scenario>. The handlers do not
+// read the bodies, so routers reading or buffering them during the dispatch,
+// e.g. parsing the form, are slower, which is reported as the bytes read per
+// op in body-B/op.
+func BenchmarkPostBody(b *testing.B) {
+	for _, router := range routers {
+		b.Run(router.Name, func(b *testing.B) {
+			for _, s := range selectedScenarios() {
+				var posts []fixtures.Route
+				for _, route := range s.Routes() {
+					if route.Method == "POST" {
+						posts = append(posts, route)
+					}
+				}
+				if len(posts) == 0 || router.Capabilities.Check(s.Routes()) != nil {
+					continue
+				}
+				b.Run(s.Name(), func(b *testing.B) {
+					benchPostBody(b, router.Load(s.Routes(), adapters.HandlerEmpty), posts)
+				})
+			}
+		})
+	}
+}
+
+func benchPostBody(b *testing.B, router http.Handler, routes []fixtures.Route) {
+	w := new(mockResponseWriter)
+	body := new(countingBody)
+	requests := make([]*http.Request, len(routes))
+	for i, route := range routes {
+		r, _ := http.NewRequest(route.Method, route.Path, nil)
+		r.RequestURI = r.URL.RequestURI()
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.ContentLength = int64(len(postBody))
+		r.Body = body
+		requests[i] = r
+	}
+
+	b.ReportAllocs()
+	for loop := benchLoop(b); loop(); {
+		for _, r := range requests {
+			body.Reset(postBody)
+			// a router parsing the form would skip an already parsed form
+			r.Form, r.PostForm = nil, nil
+			router.ServeHTTP(w, r)
+		}
+	}
+
+	b.ReportMetric(float64(body.read)/float64(b.N), "body-B/op")
+	checkResponse(b, w, 0)
+}
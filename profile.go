@@ -0,0 +1,108 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/hotspots"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/results"
+)
+
+const profileUsage = `Usage: go run . profile [flags] router1 router2 [-- go test flags]
+
+Captures a CPU profile of each of the two routers serving the same requests of
+a scenario, each in a separate go test run, and writes a comparison of the
+profiles. The time per op is split by the origin of the functions, i.e. the
+standard library shared by the routers, the harness and the code of the
+router and its dependencies, followed by the functions with the largest
+differences. Flags after -- are passed to the test binary, e.g. -benchtime.
+
+Flags:
+`
+
+// profile compares the CPU profiles of two routers in an API benchmark.
+func profile(args []string) error {
+	fs := flag.NewFlagSet("profile", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), profileUsage)
+		fs.PrintDefaults()
+	}
+	tags := fs.String("tags", "", "build `tags` of the frameworks, e.g. frameworks or \"gin echo\"")
+	scenario := fs.String("scenario", "Github", "`name` of the scenario")
+	request := fs.String("request", "All", "`name` of the request of the scenario, e.g. All or a route")
+	top := fs.Int("top", 20, "number of hotspots")
+	out := fs.String("o", "", "write the comparison to `file` instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+	names, testArgs := fs.Args()[:2], fs.Args()[2:]
+	if len(testArgs) > 0 && testArgs[0] == "--" {
+		testArgs = testArgs[1:]
+	}
+
+	dir, err := ioutil.TempDir("", "profile")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	benchmark := "API/" + *scenario + "/" + *request
+	var profiles [2]hotspots.Profile
+	for i, name := range names {
+		binary := filepath.Join(dir, strconv.Itoa(i)+".test")
+		cpuprofile := filepath.Join(dir, strconv.Itoa(i)+".prof")
+
+		a := []string{"test"}
+		if *tags != "" {
+			a = append(a, "-tags", *tags)
+		}
+		a = append(a, ".", "-run=^$", "-o", binary, "-cpuprofile", cpuprofile)
+		a = append(a, testArgs...)
+		a = append(a, "-bench=^BenchmarkAPI$/^"+regexp.QuoteMeta(*scenario)+"$/^"+regexp.QuoteMeta(name)+"$/^"+regexp.QuoteMeta(*request)+"$")
+		var p results.Parser
+		if err := runGoTest(&p, nil, a...); err != nil {
+			return err
+		}
+		if len(p.Benchmarks) == 0 {
+			return fmt.Errorf("no results of %s for %s", benchmark, name)
+		}
+
+		cmd := goCommand(nil, "tool", "pprof", "-top", "-nodecount=0", "-unit=ns", binary, cpuprofile)
+		cmd.Stderr = os.Stderr
+		top, err := cmd.Output()
+		if err != nil {
+			return fmt.Errorf("go tool pprof: %v", err)
+		}
+		flat, err := hotspots.ParseTop(bytes.NewReader(top))
+		if err != nil {
+			return fmt.Errorf("profile of %s: %v", name, err)
+		}
+		profiles[i] = hotspots.Profile{Router: name, NsPerOp: p.Benchmarks[0].NsPerOp, Flat: flat}
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return hotspots.WriteMarkdown(w, benchmark, profiles[0], profiles[1], *top)
+}
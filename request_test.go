@@ -0,0 +1,135 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// Request modes of the benchmarks, see requestSource.
+const (
+	// reuse passes the same request to the router for all routes, only
+	// resetting its method and URL. This is the cheapest mode, but a router
+	// modifying the request, e.g. its URL, might affect subsequent requests.
+	requestReuse = "reuse"
+
+	// clone passes a shallow copy of the request of the route to the router.
+	// The two allocations for the copy are included in the results.
+	requestClone = "clone"
+
+	// pool passes requests from a sync.Pool to the router, which are reset to
+	// a copy of the request of the route.
+	requestPool = "pool"
+)
+
+var requestMode = requestModeFlag(requestReuse)
+
+func init() {
+	flag.Var(&requestMode, "request.mode", "how requests are passed to the routers: reuse, clone or pool")
+}
+
+// requestModeFlag is a flag.Value for the request mode.
+type requestModeFlag string
+
+func (m *requestModeFlag) String() string {
+	return string(*m)
+}
+
+func (m *requestModeFlag) Set(s string) error {
+	switch s {
+	case requestReuse, requestClone, requestPool:
+		*m = requestModeFlag(s)
+		return nil
+	}
+	return fmt.Errorf("invalid request mode %q", s)
+}
+
+// requestSource provides the requests to the routes of a benchmark.
+type requestSource interface {
+	// request returns a request to the i-th route.
+	request(i int) *http.Request
+
+	// release is called with a request after it was served.
+	release(r *http.Request)
+}
+
+// newRequestSource returns a request source for the routes in the mode of the
+// -request.mode flag.
+func newRequestSource(routes []fixtures.Route) requestSource {
+	templates := make([]*http.Request, len(routes))
+	for i, route := range routes {
+		r, err := http.NewRequest(route.Method, route.Path, nil)
+		if err != nil {
+			panic(err)
+		}
+		r.RequestURI = r.URL.RequestURI()
+		templates[i] = r
+	}
+
+	switch requestMode {
+	case requestClone:
+		return cloneSource(templates)
+	case requestPool:
+		return &poolSource{templates: templates}
+	}
+	r, _ := http.NewRequest("GET", "/", nil)
+	return &reuseSource{templates: templates, r: r}
+}
+
+type reuseSource struct {
+	templates []*http.Request
+	r         *http.Request
+}
+
+func (s *reuseSource) request(i int) *http.Request {
+	t := s.templates[i]
+	s.r.Method = t.Method
+	s.r.RequestURI = t.RequestURI
+	s.r.URL.Path = t.URL.Path
+	s.r.URL.RawPath = t.URL.RawPath
+	s.r.URL.RawQuery = t.URL.RawQuery
+	return s.r
+}
+
+func (s *reuseSource) release(*http.Request) {}
+
+type cloneSource []*http.Request
+
+func (s cloneSource) request(i int) *http.Request {
+	r := new(http.Request)
+	*r = *s[i]
+	u := *s[i].URL
+	r.URL = &u
+	return r
+}
+
+func (s cloneSource) release(*http.Request) {}
+
+type poolSource struct {
+	templates []*http.Request
+	pool      sync.Pool
+}
+
+func (s *poolSource) request(i int) *http.Request {
+	r, _ := s.pool.Get().(*http.Request)
+	if r == nil {
+		r = &http.Request{URL: new(url.URL)}
+	}
+	u := r.URL
+	*r = *s.templates[i]
+	*u = *s.templates[i].URL
+	r.URL = u
+	return r
+}
+
+func (s *poolSource) release(r *http.Request) {
+	s.pool.Put(r)
+}
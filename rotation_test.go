@@ -0,0 +1,72 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// rotationRepeats is the number of times each route is requested per op of the
+// Rotation benchmarks, in each of the orders.
+const rotationRepeats = 16
+
+// BenchmarkRotation requests the routes of every scenario in two orders, named
+// BenchmarkRotation/<Router>/<Scenario>: each route repeatedly in a row, which
+// keeps the caches and branch predictors warm as for a single benchmarked
+// request, and all routes in turn. Both orders serve the same requests per op,
+// reported per request as repeat-ns/req and rotate-ns/req, so that their
+// difference is the share of the warmth in the numbers of single requests.
+func BenchmarkRotation(b *testing.B) {
+	for _, router := range routers {
+		b.Run(router.Name, func(b *testing.B) {
+			for _, s := range selectedScenarios() {
+				if router.Capabilities.Check(s.Routes()) != nil || !router.Capabilities.Supports(s.HandlerKind()) {
+					continue
+				}
+				b.Run(s.Name(), func(b *testing.B) {
+					benchRotation(b, router.Load(s.Routes(), s.HandlerKind()), s.Routes())
+				})
+			}
+		})
+	}
+}
+
+func benchRotation(b *testing.B, router http.Handler, routes []fixtures.Route) {
+	w := new(mockResponseWriter)
+	src := newRequestSource(routes)
+	var repeat, rotate time.Duration
+
+	serve := func(j int) {
+		r := src.request(j)
+		router.ServeHTTP(w, r)
+		src.release(r)
+	}
+
+	b.ReportAllocs()
+	for loop := benchLoop(b); loop(); {
+		start := time.Now()
+		for j := range routes {
+			for k := 0; k < rotationRepeats; k++ {
+				serve(j)
+			}
+		}
+		repeated := time.Now()
+		for k := 0; k < rotationRepeats; k++ {
+			for j := range routes {
+				serve(j)
+			}
+		}
+		repeat += repeated.Sub(start)
+		rotate += time.Since(repeated)
+	}
+
+	requests := float64(b.N) * float64(len(routes)*rotationRepeats)
+	b.ReportMetric(float64(repeat.Nanoseconds())/requests, "repeat-ns/req")
+	b.ReportMetric(float64(rotate.Nanoseconds())/requests, "rotate-ns/req")
+}
@@ -5,55 +5,61 @@
 package main
 
 import (
+	"bufio"
+	"errors"
+	"flag"
 	"fmt"
-	"io"
 	"log"
+	"net"
 	"net/http"
 	"os"
-	"regexp"
 	"runtime"
-
-	// If you add new routers please:
-	// - Keep the benchmark functions etc. alphabetically sorted
-	// - Make a pull request (without benchmark results) at
-	//   https://github.com/julienschmidt/go-http-routing-benchmark
-	"github.com/astaxie/beego"
-	"github.com/astaxie/beego/context"
-
-	"github.com/gin-gonic/gin"
-	"github.com/go-chi/chi"
-	"github.com/gorilla/mux"
-	"github.com/julienschmidt/httprouter"
-	"github.com/labstack/echo/v4"
-
-	"gopkg.in/macaron.v1"
 )
 
-type route struct {
-	method string
-	path   string
+// mockResponseWriter is an http.ResponseWriter discarding the response, which
+// records what the router wrote, e.g. to detect automatic redirects.
+// It implements http.Flusher and http.Hijacker, but can not be hijacked.
+type mockResponseWriter struct {
+	status  int   // last status code, 200 if only the body was written
+	written int64 // number of body bytes
+	flushes int   // number of calls to Flush
+	hijacks int   // number of calls to Hijack
 }
 
-type mockResponseWriter struct{}
-
 func (m *mockResponseWriter) Header() (h http.Header) {
 	return http.Header{}
 }
 
 func (m *mockResponseWriter) Write(p []byte) (n int, err error) {
+	if m.status == 0 {
+		m.status = http.StatusOK
+	}
+	m.written += int64(len(p))
 	return len(p), nil
 }
 
 func (m *mockResponseWriter) WriteString(s string) (n int, err error) {
+	if m.status == 0 {
+		m.status = http.StatusOK
+	}
+	m.written += int64(len(s))
 	return len(s), nil
 }
 
-func (m *mockResponseWriter) WriteHeader(int) {}
+func (m *mockResponseWriter) WriteHeader(code int) {
+	m.status = code
+}
 
-var nullLogger *log.Logger
+func (m *mockResponseWriter) Flush() {
+	m.flushes++
+}
+
+func (m *mockResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	m.hijacks++
+	return nil, nil, errors.New("mockResponseWriter can not be hijacked")
+}
 
-// flag indicating if the normal or the test handler should be loaded
-var loadTestHandler = false
+var nullLogger *log.Logger
 
 func init() {
 	// beego sets it to runtime.NumCPU()
@@ -63,443 +69,30 @@ func init() {
 	// makes logging 'webscale' (ignores them)
 	log.SetOutput(new(mockResponseWriter))
 	nullLogger = log.New(new(mockResponseWriter), "", 0)
-
-	initBeego()
-	initGin()
-	// initRevel()
-}
-
-// Common
-func httpHandlerFunc(_ http.ResponseWriter, _ *http.Request) {}
-
-func httpHandlerFuncTest(w http.ResponseWriter, r *http.Request) {
-	io.WriteString(w, r.RequestURI)
-}
-
-// beego
-func beegoHandler(ctx *context.Context) {}
-
-func beegoHandlerWrite(ctx *context.Context) {
-	ctx.WriteString(ctx.Input.Param(":name"))
-}
-
-func beegoHandlerTest(ctx *context.Context) {
-	ctx.WriteString(ctx.Request.RequestURI)
-}
-
-func initBeego() {
-	beego.BConfig.RunMode = beego.PROD
-	beego.BeeLogger.Close()
-}
-
-func loadBeego(routes []route) http.Handler {
-	h := beegoHandler
-	if loadTestHandler {
-		h = beegoHandlerTest
-	}
-
-	re := regexp.MustCompile(":([^/]*)")
-	app := beego.NewControllerRegister()
-	for _, route := range routes {
-		route.path = re.ReplaceAllString(route.path, ":$1")
-		switch route.method {
-		case "GET":
-			app.Get(route.path, h)
-		case "POST":
-			app.Post(route.path, h)
-		case "PUT":
-			app.Put(route.path, h)
-		case "PATCH":
-			app.Patch(route.path, h)
-		case "DELETE":
-			app.Delete(route.path, h)
-		default:
-			panic("Unknow HTTP method: " + route.method)
-		}
-	}
-	return app
-}
-
-func loadBeegoSingle(method, path string, handler beego.FilterFunc) http.Handler {
-	app := beego.NewControllerRegister()
-	switch method {
-	case "GET":
-		app.Get(path, handler)
-	case "POST":
-		app.Post(path, handler)
-	case "PUT":
-		app.Put(path, handler)
-	case "PATCH":
-		app.Patch(path, handler)
-	case "DELETE":
-		app.Delete(path, handler)
-	default:
-		panic("Unknow HTTP method: " + method)
-	}
-	return app
-}
-
-// chi
-// chi
-func chiHandleWrite(w http.ResponseWriter, r *http.Request) {
-	io.WriteString(w, chi.URLParam(r, "name"))
 }
 
-func loadChi(routes []route) http.Handler {
-	h := httpHandlerFunc
-	if loadTestHandler {
-		h = httpHandlerFuncTest
-	}
-
-	re := regexp.MustCompile(":([^/]*)")
-
-	mux := chi.NewRouter()
-	for _, route := range routes {
-		path := re.ReplaceAllString(route.path, "{$1}")
+//go:generate go test -tags frameworks -run=^TestFlatBenchmarks$ -generate
 
-		switch route.method {
-		case "GET":
-			mux.Get(path, h)
-		case "POST":
-			mux.Post(path, h)
-		case "PUT":
-			mux.Put(path, h)
-		case "PATCH":
-			mux.Patch(path, h)
-		case "DELETE":
-			mux.Delete(path, h)
-		default:
-			panic("Unknown HTTP method: " + route.method)
+// Usage notice
+func main() {
+	if len(os.Args) > 1 {
+		subcommands := map[string]func([]string) error{
+			"run":     run,
+			"profile": profile,
 		}
-	}
-	return mux
-}
-
-func loadChiSingle(method, path string, handler http.HandlerFunc) http.Handler {
-	mux := chi.NewRouter()
-	switch method {
-	case "GET":
-		mux.Get(path, handler)
-	case "POST":
-		mux.Post(path, handler)
-	case "PUT":
-		mux.Put(path, handler)
-	case "PATCH":
-		mux.Patch(path, handler)
-	case "DELETE":
-		mux.Delete(path, handler)
-	default:
-		panic("Unknown HTTP method: " + method)
-	}
-	return mux
-}
-
-// Echo
-func echoHandler(c echo.Context) error {
-	return nil
-}
-
-func echoHandlerWrite(c echo.Context) error {
-	io.WriteString(c.Response(), c.Param("name"))
-	return nil
-}
-
-func echoHandlerTest(c echo.Context) error {
-	io.WriteString(c.Response(), c.Request().RequestURI)
-	return nil
-}
-
-func loadEcho(routes []route) http.Handler {
-	var h echo.HandlerFunc = echoHandler
-	if loadTestHandler {
-		h = echoHandlerTest
-	}
-
-	e := echo.New()
-	for _, r := range routes {
-		switch r.method {
-		case "GET":
-			e.GET(r.path, h)
-		case "POST":
-			e.POST(r.path, h)
-		case "PUT":
-			e.PUT(r.path, h)
-		case "PATCH":
-			e.PATCH(r.path, h)
-		case "DELETE":
-			e.DELETE(r.path, h)
-		default:
-			panic("Unknow HTTP method: " + r.method)
+		if subcommand, ok := subcommands[os.Args[1]]; ok {
+			if err := subcommand(os.Args[2:]); err != nil {
+				if err != flag.ErrHelp {
+					fmt.Fprintln(os.Stderr, err)
+				}
+				os.Exit(1)
+			}
+			return
 		}
 	}
-	return e
-}
-
-func loadEchoSingle(method, path string, h echo.HandlerFunc) http.Handler {
-	e := echo.New()
-	switch method {
-	case "GET":
-		e.GET(path, h)
-	case "POST":
-		e.POST(path, h)
-	case "PUT":
-		e.PUT(path, h)
-	case "PATCH":
-		e.PATCH(path, h)
-	case "DELETE":
-		e.DELETE(path, h)
-	default:
-		panic("Unknow HTTP method: " + method)
-	}
-	return e
-}
-
-// Gin
-func ginHandle(_ *gin.Context) {}
-
-func ginHandleWrite(c *gin.Context) {
-	io.WriteString(c.Writer, c.Params.ByName("name"))
-}
-
-func ginHandleTest(c *gin.Context) {
-	io.WriteString(c.Writer, c.Request.RequestURI)
-}
-
-func initGin() {
-	gin.SetMode(gin.ReleaseMode)
-}
-
-func loadGin(routes []route) http.Handler {
-	h := ginHandle
-	if loadTestHandler {
-		h = ginHandleTest
-	}
-
-	router := gin.New()
-	for _, route := range routes {
-		router.Handle(route.method, route.path, h)
-	}
-	return router
-}
-
-func loadGinSingle(method, path string, handle gin.HandlerFunc) http.Handler {
-	router := gin.New()
-	router.Handle(method, path, handle)
-	return router
-}
-
-// gorilla/mux
-func gorillaHandlerWrite(w http.ResponseWriter, r *http.Request) {
-	params := mux.Vars(r)
-	io.WriteString(w, params["name"])
-}
-
-func loadGorillaMux(routes []route) http.Handler {
-	h := httpHandlerFunc
-	if loadTestHandler {
-		h = httpHandlerFuncTest
-	}
-
-	re := regexp.MustCompile(":([^/]*)")
-	m := mux.NewRouter()
-	for _, route := range routes {
-		m.HandleFunc(
-			re.ReplaceAllString(route.path, "{$1}"),
-			h,
-		).Methods(route.method)
-	}
-	return m
-}
-
-func loadGorillaMuxSingle(method, path string, handler http.HandlerFunc) http.Handler {
-	m := mux.NewRouter()
-	m.HandleFunc(path, handler).Methods(method)
-	return m
-}
-
-// HttpRouter
-func httpRouterHandle(_ http.ResponseWriter, _ *http.Request, _ httprouter.Params) {}
-
-func httpRouterHandleWrite(w http.ResponseWriter, _ *http.Request, ps httprouter.Params) {
-	io.WriteString(w, ps.ByName("name"))
-}
-
-func httpRouterHandleTest(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
-	io.WriteString(w, r.RequestURI)
-}
-
-func loadHttpRouter(routes []route) http.Handler {
-	h := httpRouterHandle
-	if loadTestHandler {
-		h = httpRouterHandleTest
-	}
-
-	router := httprouter.New()
-	for _, route := range routes {
-		router.Handle(route.method, route.path, h)
-	}
-	return router
-}
-
-func loadHttpRouterSingle(method, path string, handle httprouter.Handle) http.Handler {
-	router := httprouter.New()
-	router.Handle(method, path, handle)
-	return router
-}
-
-// Macaron
-func macaronHandler() {}
-
-func macaronHandlerWrite(c *macaron.Context) string {
-	return c.Params("name")
-}
-
-func macaronHandlerTest(c *macaron.Context) string {
-	return c.Req.RequestURI
-}
-
-func loadMacaron(routes []route) http.Handler {
-	var h = []macaron.Handler{macaronHandler}
-	if loadTestHandler {
-		h[0] = macaronHandlerTest
-	}
-
-	m := macaron.New()
-	for _, route := range routes {
-		m.Handle(route.method, route.path, h)
-	}
-	return m
-}
-
-func loadMacaronSingle(method, path string, handler interface{}) http.Handler {
-	m := macaron.New()
-	m.Handle(method, path, []macaron.Handler{handler})
-	return m
-}
-
-// Revel (Router only)
-// In the following code some Revel internals are modeled.
-// The original revel code is copyrighted by Rob Figueiredo.
-// See https://github.com/revel/revel/blob/master/LICENSE
-// type RevelController struct {
-// 	*revel.Controller
-// 	router *revel.Router
-// }
-
-// func (rc *RevelController) Handle() revel.Result {
-// 	return revelResult{}
-// }
-
-// func (rc *RevelController) HandleWrite() revel.Result {
-// 	return rc.RenderText(rc.Params.Get("name"))
-// }
-
-// func (rc *RevelController) HandleTest() revel.Result {
-// 	return rc.RenderText(rc.Request.GetRequestURI())
-// }
-
-// type revelResult struct{}
 
-// func (rr revelResult) Apply(req *revel.Request, resp *revel.Response) {}
-
-// func (rc *RevelController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-// 	// Dirty hacks, do NOT copy!
-// 	revel.MainRouter = rc.router
-
-// 	upgrade := r.Header.Get("Upgrade")
-// 	if upgrade == "websocket" || upgrade == "Websocket" {
-// 		panic("Not implemented")
-// 	} else {
-// 		var (
-// 			req  = revel.NewRequest(r)
-// 			resp = revel.NewResponse(w)
-// 			c    = revel.NewController(req, resp)
-// 		)
-// 		req.Websocket = nil
-// 		revel.Filters[0](c, revel.Filters[1:])
-// 		if c.Result != nil {
-// 			c.Result.Apply(req, resp)
-// 		} else if c.Response.Status != 0 {
-// 			panic("Not implemented")
-// 		}
-// 		// Close the Writer if we can
-// 		if w, ok := resp.Out.(io.Closer); ok {
-// 			w.Close()
-// 		}
-// 	}
-// }
-
-// func initRevel() {
-// 	// Only use the Revel filters required for this benchmark
-// 	revel.Filters = []revel.Filter{
-// 		revel.RouterFilter,
-// 		revel.ParamsFilter,
-// 		revel.ActionInvoker,
-// 	}
-
-// 	revel.RegisterController((*RevelController)(nil),
-// 		[]*revel.MethodType{
-// 			{
-// 				Name: "Handle",
-// 			},
-// 			{
-// 				Name: "HandleWrite",
-// 			},
-// 			{
-// 				Name: "HandleTest",
-// 			},
-// 		})
-// }
-
-// func loadRevel(routes []route) http.Handler {
-// 	h := "RevelController.Handle"
-// 	if loadTestHandler {
-// 		h = "RevelController.HandleTest"
-// 	}
-
-// 	router := revel.NewRouter("")
-
-// 	// parseRoutes
-// 	var rs []*revel.Route
-// 	for _, r := range routes {
-// 		rs = append(rs, revel.NewRoute(r.method, r.path, h, "", "", 0))
-// 	}
-// 	router.Routes = rs
-
-// 	// updateTree
-// 	router.Tree = pathtree.New()
-// 	for _, r := range router.Routes {
-// 		err := router.Tree.Add(r.TreePath, r)
-// 		// Allow GETs to respond to HEAD requests.
-// 		if err == nil && r.Method == "GET" {
-// 			err = router.Tree.Add("/HEAD"+r.Path, r)
-// 		}
-// 		// Error adding a route to the pathtree.
-// 		if err != nil {
-// 			panic(err)
-// 		}
-// 	}
-
-// 	rc := new(RevelController)
-// 	rc.router = router
-// 	return rc
-// }
-
-// func loadRevelSingle(method, path, action string) http.Handler {
-// 	router := revel.NewRouter("")
-
-// 	route := revel.NewRoute(method, path, action, "", "", 0)
-// 	if err := router.Tree.Add(route.TreePath, route); err != nil {
-// 		panic(err)
-// 	}
-
-// 	rc := new(RevelController)
-// 	rc.router = router
-// 	return rc
-// }
-
-// Usage notice
-func main() {
 	fmt.Println("Usage: go test -bench=. -timeout=20m")
+	fmt.Println("   or: go run . run -h")
+	fmt.Println("   or: go run . profile -h")
 	os.Exit(1)
 }
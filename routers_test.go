@@ -1,64 +1,199 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/scenarios"
 )
 
-var (
-	// load functions of all routers
-	routers = []struct {
-		name string
-		load func(routes []route) http.Handler
-	}{
-		{"Beego", loadBeego},
-		{"Chi", loadChi},
-		{"Echo", loadEcho},
-		{"Gin", loadGin},
-		{"GorillaMux", loadGorillaMux},
-		{"HttpRouter", loadHttpRouter},
-		{"Macaron", loadMacaron},
-		// {"Revel", loadRevel},
+func TestMain(m *testing.M) {
+	flag.Parse()
+	err := parseBenchFlag()
+	if err == nil {
+		err = registerFlagScenarios()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if *listRoutersFlag {
+		for _, router := range routers {
+			fmt.Println(router.Name)
+		}
+		if routerSelected("HttpServeMux") {
+			fmt.Println("HttpServeMux")
+		}
+		if routerSelected(baselineName) {
+			fmt.Println(baselineName)
+		}
+		os.Exit(0)
 	}
 
-	// all APIs
-	apis = []struct {
-		name   string
-		routes []route
-	}{
-		{"GitHub", githubAPI},
-		{"Static", staticRoutes},
+	// Scenarios might be registered in init functions of any file, thus they
+	// can only be loaded once all init functions ran.
+	loadScenarios()
+	generateCorpora()
+	loadStatic()
+	loadTenants()
+	if benchRes != nil {
+		printSemantics()
 	}
-)
+
+	os.Exit(m.Run())
+}
 
 func TestRouters(t *testing.T) {
-	loadTestHandler = true
+	for _, router := range routers {
+		testRouter(t, router)
+	}
+}
 
+// TestLoad loads the routes of every registered scenario into every router
+// with each handler kind, so that routers panicking for routes they claim to
+// support are found before the benchmarks, which skip them as unsupported.
+func TestLoad(t *testing.T) {
 	for _, router := range routers {
-		req, _ := http.NewRequest("GET", "/", nil)
-		u := req.URL
-		rq := u.RawQuery
+		for _, s := range scenarios.All() {
+			if err := router.Capabilities.Check(s.Routes()); err != nil {
+				continue // logged by testRouter
+			}
+			for kind := adapters.HandlerEmpty; kind <= adapters.HandlerMethod; kind++ {
+				if !router.Capabilities.Supports(kind) {
+					continue
+				}
+				if _, err := tryLoad(router, s.Routes(), kind); err != nil {
+					t.Errorf("%s in scenario %s with handler kind %d: unsupported, %v", router.Name, s.Name(), kind, err)
+				}
+			}
+		}
+	}
+}
+
+func TestWriteHandlers(t *testing.T) {
+	for _, router := range routers {
+		testRouterWrite(t, router)
+	}
+}
+
+func TestVariants(t *testing.T) {
+	if !allFrameworks {
+		t.Skip("conf/variants.yaml requires all frameworks, run with -tags frameworks")
+	}
+	variants, err := loadVariants("conf/variants.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, router := range variants {
+		testRouter(t, router)
+		testRouterWrite(t, router)
+		for _, err := range dispatch(router, methodRoutes) {
+			t.Errorf("%s: %s", router.Name, err)
+		}
+		for _, err := range dispatchSingle(router, methodRoutes) {
+			t.Errorf("%s with a single route: %s", router.Name, err)
+		}
+	}
+}
+
+// testRouter checks that the router routes the requests of every scenario it
+// supports to the respective route.
+func testRouter(t *testing.T, router adapters.Router) {
+	req, _ := http.NewRequest("GET", "/", nil)
+	u := req.URL
+	rq := u.RawQuery
 
-		for _, api := range apis {
-			r := router.load(api.routes)
+	for _, s := range selectedScenarios() {
+		if err := router.Capabilities.Check(s.Routes()); err != nil {
+			t.Logf("%s in scenario %s: skipped, %v", router.Name, s.Name(), err)
+			continue
+		}
+		r := router.Load(s.Routes(), adapters.HandlerTest)
+
+		for _, route := range s.Routes() {
+			w := httptest.NewRecorder()
+			req.Method = route.Method
+			req.RequestURI = route.Path
+			u.Path = route.Path
+			u.RawQuery = rq
+			r.ServeHTTP(w, req)
+			if w.Code != 200 || w.Body.String() != route.Path {
+				t.Errorf(
+					"%s in scenario %s: %d - %s; expected %s %s\n",
+					router.Name, s.Name(), w.Code, w.Body.String(), route.Method, route.Path,
+				)
+			}
+		}
+	}
+}
+
+// testRouterWrite checks that the write handlers of the router, which the
+// ParamWrite and ParamContextWrite benchmarks rely on, echo the value of the
+// name param, both for the micro benchmarks and for every route of every
+// scenario it supports.
+func testRouterWrite(t *testing.T, router adapters.Router) {
+	kinds := []struct {
+		kind adapters.HandlerKind
+		name string
+	}{
+		{adapters.HandlerWrite, "write handler"},
+		{adapters.HandlerContextWrite, "context write handler"},
+	}
+	for _, k := range kinds {
+		kind, handler := k.kind, k.name
+		if !router.Capabilities.Supports(kind) {
+			continue
+		}
+
+		for _, bm := range microBenchmarks {
+			if bm.kind != kind {
+				continue
+			}
+			h := router.LoadSingle("GET", bm.path, kind)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest("GET", bm.request, nil))
+			if w.Code != 200 || w.Body.String() != bm.body {
+				t.Errorf(
+					"%s %s in micro benchmark %s: %d - %q; expected %q\n",
+					router.Name, handler, bm.name, w.Code, w.Body.String(), bm.body,
+				)
+			}
+		}
+
+		for _, s := range selectedScenarios() {
+			if router.Capabilities.Check(s.Routes()) != nil {
+				continue // logged by testRouter
+			}
+			r := router.Load(s.Routes(), kind)
+
+			for _, route := range s.Routes() {
+				// some routers do not match a param against the literal
+				// param segment, thus the name param gets a proper value
+				want := ""
+				segs := strings.Split(route.Path, "/")
+				for i, seg := range segs {
+					if seg == ":name" {
+						want = "gordon"
+						segs[i] = want
+					}
+				}
+				path := strings.Join(segs, "/")
 
-			for _, route := range api.routes {
 				w := httptest.NewRecorder()
-				req.Method = route.method
-				req.RequestURI = route.path
-				u.Path = route.path
-				u.RawQuery = rq
-				r.ServeHTTP(w, req)
-				if w.Code != 200 || w.Body.String() != route.path {
+				r.ServeHTTP(w, httptest.NewRequest(route.Method, path, nil))
+				if w.Code != 200 || w.Body.String() != want {
 					t.Errorf(
-						"%s in API %s: %d - %s; expected %s %s\n",
-						router.name, api.name, w.Code, w.Body.String(), route.method, route.path,
+						"%s %s in scenario %s: %d - %q; expected %q for %s %s\n",
+						router.Name, handler, s.Name(), w.Code, w.Body.String(), want, route.Method, path,
 					)
 				}
 			}
 		}
 	}
-
-	loadTestHandler = false
 }
@@ -0,0 +1,236 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil" // os.MkdirTemp and os.WriteFile need Go 1.16, the module supports Go 1.13
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/results"
+)
+
+const runUsage = `Usage: go run . run [flags] [-- go test flags]
+
+Runs the benchmarks with go test and writes a report of the results, in
+Markdown, JSON or CSV, which only has the benchmark results. The output of go
+test is streamed to stderr. Flags after -- are passed to the test
+binary, e.g. -routers, -scenarios or -benchtime.
+
+With -matrix, the benchmarks are run once per listed module version in a copy
+of the workspace which replaces the module by that version. The routers are
+named after the version of their module, e.g. Gin@v1.7.7, so several versions
+of the same router end up in a single report.
+
+Flags:
+`
+
+// reportWriters are the writers of the reports by the -format of the run mode.
+var reportWriters = map[string]func(io.Writer, results.Results) error{
+	"markdown": results.WriteMarkdown,
+	"json":     results.WriteJSON,
+	"csv":      results.WriteCSV,
+}
+
+// run runs the benchmarks with go test and writes a report of the results.
+func run(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), runUsage)
+		fs.PrintDefaults()
+	}
+	tags := fs.String("tags", "", "build `tags` of the frameworks, e.g. frameworks or \"gin echo\"")
+	bench := fs.String("bench", ".", "run only the benchmarks matching the `regexp`")
+	perRouter := fs.Bool("per-router", false, "run the benchmarks of each router in a separate process")
+	out := fs.String("o", "", "write the report to `file` instead of stdout")
+	format := fs.String("format", "markdown", "`format` of the report: markdown, json or csv")
+	matrix := fs.String("matrix", "", "comma-separated `module@version` list to benchmark, e.g. github.com/gin-gonic/gin@v1.7.7,github.com/gin-gonic/gin@v1.9.1")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	write, ok := reportWriters[*format]
+	if !ok {
+		return fmt.Errorf("unknown report format %q, want markdown, json or csv", *format)
+	}
+	if *tags == "" {
+		fmt.Fprintln(os.Stderr, "no -tags set, benchmarking only the routers of the main module; use -tags frameworks for all frameworks")
+	}
+
+	// an empty workspace is the one of the current directory
+	workspaces := []string{""}
+	if *matrix != "" {
+		dir, err := ioutil.TempDir("", "matrix")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+
+		workspaces = nil
+		for _, mv := range strings.Split(*matrix, ",") {
+			i := strings.LastIndex(mv, "@")
+			if i <= 0 {
+				return fmt.Errorf("invalid matrix entry %q, want module@version", mv)
+			}
+			work, err := versionWorkspace(filepath.Join(dir, strconv.Itoa(len(workspaces))), mv[:i], mv[i+1:])
+			if err != nil {
+				return err
+			}
+			workspaces = append(workspaces, work)
+		}
+	}
+
+	var p results.Parser
+	for _, work := range workspaces {
+		var env, versions []string
+		if work != "" {
+			env = []string{"GOWORK=" + work}
+			versions = []string{"-versions"}
+		}
+		goTest := func(testArgs ...string) []string {
+			a := []string{"test"}
+			if *tags != "" {
+				a = append(a, "-tags", *tags)
+			}
+			a = append(a, ".", "-run=^$")
+			a = append(a, versions...)
+			// the latter of repeated flags wins
+			a = append(a, fs.Args()...)
+			return append(a, testArgs...)
+		}
+
+		if !*perRouter {
+			if err := runGoTest(&p, env, goTest("-bench="+*bench)...); err != nil {
+				return err
+			}
+			continue
+		}
+		routers, err := listRouters(env, goTest("-v", "-list.routers")...)
+		if err != nil {
+			return err
+		}
+		for _, router := range routers {
+			err := runGoTest(&p, env, goTest("-bench="+*bench, "-routers=^"+regexp.QuoteMeta(router)+"$")...)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return write(w, p.Results)
+}
+
+// runGoTest runs the go command with the arguments and the additional
+// environment variables, streams its output to stderr and feeds it to the
+// parser.
+func runGoTest(p *results.Parser, env []string, args ...string) error {
+	fmt.Fprintln(os.Stderr, strings.Join(append(env, "go"), " "), strings.Join(args, " "))
+	cmd := goCommand(env, args...)
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	s := bufio.NewScanner(stdout)
+	for s.Scan() {
+		fmt.Fprintln(os.Stderr, s.Text())
+		p.ParseLine(s.Text())
+	}
+	if err := s.Err(); err != nil {
+		cmd.Wait()
+		return err
+	}
+	return cmd.Wait()
+}
+
+// listRouters returns the names of the benchmarked routers, as listed by the
+// test binary with the -list.routers flag.
+func listRouters(env []string, args ...string) ([]string, error) {
+	cmd := goCommand(env, args...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var routers []string
+	for _, line := range strings.Split(string(bytes.TrimSpace(out)), "\n") {
+		// skip the summary of go test
+		if line != "" && !strings.ContainsAny(line, " \t") {
+			routers = append(routers, line)
+		}
+	}
+	return routers, nil
+}
+
+// goCommand returns a go command with the arguments and the additional
+// environment variables.
+func goCommand(env []string, args ...string) *exec.Cmd {
+	cmd := exec.Command("go", args...)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	return cmd
+}
+
+// versionWorkspace writes a copy of the workspace of the current directory
+// into dir, in which the module is replaced by the given version, and returns
+// the path of its go.work file.
+func versionWorkspace(dir, module, version string) (string, error) {
+	out, err := exec.Command("go", "work", "edit", "-json").Output()
+	if err != nil {
+		return "", fmt.Errorf("go work edit: %v", err)
+	}
+	var work struct {
+		Go  string
+		Use []struct{ DiskPath string }
+	}
+	if err := json.Unmarshal(out, &work); err != nil {
+		return "", err
+	}
+	root, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "go %s\n\nuse (\n", work.Go)
+	for _, use := range work.Use {
+		path := use.DiskPath
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(root, path)
+		}
+		fmt.Fprintf(&b, "\t%s\n", path)
+	}
+	fmt.Fprintf(&b, ")\n\nreplace %s => %s %s\n", module, module, version)
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	filename := filepath.Join(dir, "go.work")
+	return filename, ioutil.WriteFile(filename, []byte(b.String()), 0644)
+}
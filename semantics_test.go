@@ -0,0 +1,140 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// semanticsRoutes are the routes the routers are loaded with for the probes.
+var semanticsRoutes = []fixtures.Route{
+	{Method: "GET", Path: "/user/:name"},
+	{Method: "GET", Path: "/users/repos"},
+	{Method: "GET", Path: "/caf\u00e9"}, // NFC
+}
+
+// probes are requests matching none of the semanticsRoutes, which tell how a
+// router handles unmatched requests. Lenient routers may match the requests
+// of some probes anyway, e.g. ignoring a trailing slash.
+var probes = []struct {
+	name    string
+	method  string
+	path    string
+	lenient bool // routers may match the request
+}{
+	{"NotFound", "GET", "/missing", false},
+	{"MethodNotAllowed", "DELETE", "/user/gordon", false},
+	{"TrailingSlash", "GET", "/user/gordon/", true},
+	{"FixedPath", "GET", "/USERS/repos", true},
+	{"NFD", "GET", "/cafe%CC%81", true},        // e followed by a combining acute accent
+	{"UnicodeCase", "GET", "/CAF%C3%89", true}, // upper case É
+}
+
+// probeRouter requests each of the probes from the router loaded with the
+// semanticsRoutes and returns the outcomes, which are "match" if the router
+// matched a route anyway, "405+Allow" if the response has an Allow header,
+// "redirect" for redirects, "panic" or the status code otherwise.
+func probeRouter(router adapters.Router) []string {
+	h := router.Load(semanticsRoutes, adapters.HandlerTest)
+
+	outcomes := make([]string, len(probes))
+	for i, p := range probes {
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					outcomes[i] = "panic"
+				}
+			}()
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(p.method, p.path, nil))
+			switch {
+			case w.Code == 200 && w.Body.String() == p.path:
+				outcomes[i] = "match"
+			case w.Code == 405 && w.Header().Get("Allow") != "":
+				outcomes[i] = "405+Allow"
+			case w.Code >= 300 && w.Code < 400:
+				outcomes[i] = "redirect"
+			default:
+				outcomes[i] = fmt.Sprint(w.Code)
+			}
+		}()
+	}
+	return outcomes
+}
+
+// TestSemantics checks that the routers match the semanticsRoutes, but do not
+// panic for the probes or match the requests of strict probes. With -v, it
+// logs the outcomes of the probes per router.
+func TestSemantics(t *testing.T) {
+	names := make([]string, len(probes))
+	for i, p := range probes {
+		names[i] = p.name
+	}
+	matrix := outcomeMatrix(names, func(router adapters.Router) []string {
+		// the probes only tell something, if the routes match
+		h := router.Load(semanticsRoutes, adapters.HandlerTest)
+		for _, route := range semanticsRoutes {
+			path := (&url.URL{Path: fixtures.NewSample(route).Path}).EscapedPath()
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(route.Method, path, nil))
+			if w.Code != 200 {
+				t.Errorf("%s: %s %s: status %d", router.Name, route.Method, path, w.Code)
+			}
+		}
+
+		outcomes := probeRouter(router)
+		for i, outcome := range outcomes {
+			if outcome == "panic" || outcome == "match" && !probes[i].lenient {
+				t.Errorf("%s: %s %s: %s", router.Name, probes[i].method, probes[i].path, outcome)
+			}
+		}
+		return outcomes
+	})
+	t.Logf("outcomes of the probes\n%s", matrix)
+}
+
+// outcomeMatrix formats the outcomes of the named probes per router as a
+// table.
+func outcomeMatrix(names []string, outcomes func(adapters.Router) []string) string {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 0, 1, ' ', 0)
+	fmt.Fprint(tw, "\t")
+	for _, name := range names {
+		fmt.Fprintf(tw, "%s\t", name)
+	}
+	fmt.Fprintln(tw)
+	for _, router := range routers {
+		fmt.Fprintf(tw, "%s\t", router.Name)
+		for _, outcome := range outcomes(router) {
+			fmt.Fprintf(tw, "%s\t", outcome)
+		}
+		fmt.Fprintln(tw)
+	}
+	tw.Flush()
+	return b.String()
+}
+
+// printSemantics prints the outcomes of the probes and the escape probes per
+// router.
+func printSemantics() {
+	println("#Semantics")
+	for _, router := range routers {
+		for i, outcome := range probeRouter(router) {
+			println("   "+router.Name+": semantics,", probes[i].name, outcome)
+		}
+		for i, outcome := range escapeRouter(router) {
+			println("   "+router.Name+": semantics,", escapeProbes[i].name, outcome)
+		}
+	}
+	println()
+}
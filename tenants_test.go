@@ -0,0 +1,50 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"net/http"
+	"runtime"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+)
+
+// tenants is the number of router instances of the Tenants measurement.
+const tenants = 1000
+
+var tenantsFlag = flag.Bool("tenants", false, "measure the heap of 1000 instances of each router loaded with the Parse API")
+
+// loadTenants prints the memory required for tenants instances of every
+// selected router, each loaded with the Parse API, like a gateway keeping a
+// route table per tenant. The routes of all instances are printed as the routes
+// of the Tenants API.
+func loadTenants() {
+	if !*tenantsFlag {
+		return
+	}
+
+	println("#Tenants Routes:", tenants*len(fixtures.ParseAPI))
+	for _, router := range routers {
+		if err := router.Capabilities.Check(fixtures.ParseAPI); err != nil {
+			println("   "+router.Name+": skipped,", err.Error())
+			continue
+		}
+		if _, err := tryLoad(router, fixtures.ParseAPI, adapters.HandlerEmpty); err != nil {
+			println("   "+router.Name+": unsupported,", err.Error())
+			continue
+		}
+
+		instances := make([]http.Handler, 0, tenants)
+		calcMem(router.Name, func() {
+			for i := 0; i < tenants; i++ {
+				instances = append(instances, router.Load(fixtures.ParseAPI, adapters.HandlerEmpty))
+			}
+		})
+		runtime.KeepAlive(instances)
+	}
+	println()
+}
@@ -0,0 +1,97 @@
+// Copyright 2013 Julien Schmidt. All rights reserved.
+// Use of this source code is governed by a BSD-style license that can be found
+// in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/adapters"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/fixtures"
+	"github.com/julienschmidt/go-http-routing-benchmark/benchmark/scenarios"
+)
+
+// recordingWriter is a mockResponseWriter, which also records the body.
+type recordingWriter struct {
+	mockResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	w.body.Write(p)
+	return w.mockResponseWriter.Write(p)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.mockResponseWriter.WriteString(s)
+}
+
+// verifyRouters holds the routers loaded with the HandlerParams handlers for
+// the routes of each scenario, keyed by scenario and router name.
+var verifyRouters = make(map[string]map[string]http.Handler)
+
+// verifyScenarioRequest verifies a request of a scenario with the router if
+// the -verify flag is set, see verifyRequests.
+func verifyScenarioRequest(b *testing.B, router adapters.Router, s scenarios.Scenario, req scenarios.Request) {
+	if !*verifyFlag {
+		return
+	}
+	b.StopTimer()
+	if verifyRouters[s.Name()] == nil {
+		verifyRouters[s.Name()] = make(map[string]http.Handler)
+	}
+	h, ok := verifyRouters[s.Name()][router.Name]
+	if !ok {
+		h = router.Load(s.Routes(), adapters.HandlerParams)
+		verifyRouters[s.Name()][router.Name] = h
+	}
+	b.StartTimer()
+	verifyRequests(b, h, s.Routes(), req.Routes)
+}
+
+// verifyRequests serves the requests b.N times by the router, which is loaded
+// with the routes and the HandlerParams handlers, i.e. once if the benchmark
+// loops with b.Loop, which runs the benchmark function only once before the
+// loop. It fails the benchmark if the router does not extract the params of a
+// request in any iteration, e.g. because it does not match the request at all.
+// This catches routers which are fast because they skip some of the work.
+// Requests matching none of the routes are not verified. The timer is stopped
+// during verification.
+func verifyRequests(b *testing.B, h http.Handler, routes, requests []fixtures.Route) {
+	b.StopTimer()
+	defer b.StartTimer()
+
+	samples := make([]*fixtures.Sample, len(requests))
+	for i, req := range requests {
+		if route, params, ok := fixtures.Lookup(routes, req.Method, req.Path); ok {
+			samples[i] = &fixtures.Sample{Route: route, Path: req.Path, Params: params}
+		}
+	}
+
+	src := newRequestSource(requests)
+	w := new(recordingWriter)
+	for n := 0; n < b.N; n++ {
+		for i, sample := range samples {
+			if sample == nil {
+				continue
+			}
+			w.status = 0
+			w.body.Reset()
+			r := src.request(i)
+			h.ServeHTTP(w, r)
+			src.release(r)
+
+			if w.status != 0 && w.status != http.StatusOK {
+				b.Fatalf("verify %s %s: router responded with status %d", requests[i].Method, requests[i].Path, w.status)
+			}
+			if diffs := diffParams(*sample, w.body.String()); len(diffs) > 0 {
+				b.Fatalf("verify %s %s: %s", requests[i].Method, requests[i].Path, strings.Join(diffs, ", "))
+			}
+		}
+	}
+}